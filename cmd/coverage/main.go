@@ -0,0 +1,137 @@
+// Command coverage reports which words of a folder of liturgical texts are in the syllable databases, and how often they appear,
+// so curators know which missing words to add to the liturgical database first.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/boltsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/sitesyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	dir := flag.String("dir", "", "folder with the texts, read recursively")
+	ext := flag.String("ext", ".txt", "extension of the text files")
+	dbPath := flag.String("db", "", "path to the embedded database file; the JSON and TXT files are used when it is not given")
+	language := flag.String("lang", "pt", "language of the words in the embedded database")
+	liturgicalPath := flag.String("liturgical", "assets/syllabledatabases/liturgical_syllables.json", "path to the liturgical syllables file")
+	userPath := flag.String("user", "assets/syllabledatabases/user_syllables.json", "path to the user syllables file")
+	notSyllabifiedPath := flag.String("notsyllabified", "assets/syllabledatabases/not_syllabified.txt", "path to the not syllabified words file")
+	missing := flag.Bool("missing", false, "list only the words no database has")
+	top := flag.Int("top", 0, "list only this many words, the most frequent first; 0 lists all of them")
+	flag.Parse()
+
+	if *dir == "" {
+		return fmt.Errorf("the -dir flag is required")
+	}
+
+	texts, err := readTexts(*dir, *ext)
+	if err != nil {
+		return err
+	}
+
+	var syllabifier words.Syllabifier
+	if *dbPath != "" {
+		db := boltsyllabifier.NewSyllabifier(*dbPath, *language, nil)
+		db.SetValidationMode(words.Lenient)
+		if err := db.LoadSyllables(); err != nil {
+			return err
+		}
+		defer db.Close()
+		syllabifier = db
+	} else {
+		site := sitesyllabifier.NewSyllabifier(*liturgicalPath, *userPath, *notSyllabifiedPath)
+		site.SetValidationMode(words.Lenient)
+		if err := site.LoadSyllables(); err != nil {
+			return err
+		}
+		syllabifier = site
+	}
+
+	coverage, err := service.NewGabcGenAPI(syllabifier).CorpusCoverage(context.Background(), texts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%v texts, %v words: %v liturgical, %v user, %v missing (%.1f%% covered)\n\n",
+		coverage.Texts, coverage.Total, coverage.Liturgical, coverage.User, coverage.Missing, percent(coverage.Total-coverage.Missing, coverage.Total))
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "COUNT\tWORD\tDATABASE")
+
+	listed := 0
+	for _, w := range coverage.Words {
+		if *missing && w.Database != "" {
+			continue
+		}
+
+		if *top > 0 && listed == *top {
+			break
+		}
+
+		database := w.Database
+		if database == "" {
+			database = "-"
+		}
+
+		fmt.Fprintf(tw, "%v\t%v\t%v\n", w.Count, w.Word, database)
+		listed++
+	}
+
+	return tw.Flush()
+}
+
+// readTexts reads every file with the extension in the folder and its subfolders.
+func readTexts(dir, ext string) ([]string, error) {
+	var texts []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !strings.EqualFold(filepath.Ext(path), ext) {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		texts = append(texts, string(data))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading texts of %v: %w", dir, err)
+	}
+
+	return texts, nil
+}
+
+// percent is the part of the total as a percentage, or 0 for an empty total.
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return 100 * float64(part) / float64(total)
+}
@@ -3,17 +3,25 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/boltsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/latinsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/persister"
 	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/sitesyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/teamdictionary"
 	"github.com/ramon-reichert/gabcgen/internal/platform/web"
 	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
 )
 
 func main() {
@@ -24,15 +32,46 @@ func main() {
 }
 
 func run() error {
+	if len(os.Args) > 1 && os.Args[1] == "validate-db" {
+		return validateDb(os.Args[2:])
+	}
+
 	// Initialize dependencies
-	syllabifier := sitesyllabifier.NewSyllabifier("assets/syllabledatabases/liturgical_syllables.json", "assets/syllabledatabases/user_syllables.json", "assets/syllabledatabases/not_syllabified.txt")
+	syllabifier := newSyllabifier(os.Getenv("SYLLABLES_DB_PATH"))
+
+	if os.Getenv("SYLLABLES_VALIDATION") == "lenient" {
+		syllabifier.SetValidationMode(words.Lenient)
+	}
 
 	if err := syllabifier.LoadSyllables(); err != nil {
 		return fmt.Errorf("loading syllables db files: %w", err)
 	}
 
+	// Load the private dictionaries of the teams when their directory is given
+	var teams *teamdictionary.Store
+	if dir := os.Getenv("TEAM_DICTIONARIES_PATH"); dir != "" {
+		teams = teamdictionary.NewStore(dir)
+		if err := teams.LoadDictionaries(); err != nil {
+			return fmt.Errorf("loading team dictionaries: %w", err)
+		}
+	}
+
+	// Save new syllables in the background instead of at the end of each request
+	syllabPersister := persister.New(syllabifier, persister.Config{Interval: time.Minute, MaxPending: 20})
+	persisterCtx, stopPersister := context.WithCancel(context.Background())
+	persisterDone := make(chan struct{})
+
+	go func() {
+		syllabPersister.Run(persisterCtx)
+		close(persisterDone)
+	}()
+
 	// Initialize service with dependencies
 	generatorAPI := service.NewGabcGenAPI(syllabifier /*, render*/)
+	generatorAPI.Languages = map[string]words.Syllabifier{"la": latinsyllabifier.NewSyllabifier()} // Latin words are syllabified by rules, out of the shared databases
+	if teams != nil {
+		generatorAPI.Teams = teams
+	}
 
 	// Initialize http handler with service dependency
 	gabcHandler := web.NewGabcHandler(generatorAPI, time.Duration(10*time.Second))
@@ -40,7 +79,35 @@ func run() error {
 	// Setup http routes
 	mux := http.NewServeMux()
 	mux.HandleFunc("/ping", web.Ping)
-	mux.HandleFunc("/preface", gabcHandler.Preface)
+
+	// Setup routes that may select a team by its API key
+	teamHandler := web.NewTeamHandler(generatorAPI)
+	teamKeys := web.TeamKeys(parseTeamKeys(os.Getenv("TEAM_API_KEYS")))
+	mux.Handle("/preface", teamKeys(http.HandlerFunc(gabcHandler.Preface)))
+	mux.Handle("/oration", teamKeys(http.HandlerFunc(gabcHandler.Oration)))
+	mux.Handle("/reading", teamKeys(http.HandlerFunc(gabcHandler.Reading)))
+	mux.Handle("/reading/announcement", teamKeys(http.HandlerFunc(gabcHandler.Announcement)))
+	mux.HandleFunc("/reading/acclamation", gabcHandler.Acclamation)
+	mux.Handle("/psalm", teamKeys(http.HandlerFunc(gabcHandler.Psalm)))
+	mux.Handle("/psalm/canticle", teamKeys(http.HandlerFunc(gabcHandler.Canticle)))
+	mux.Handle("/psalm/responsorial", teamKeys(http.HandlerFunc(gabcHandler.Responsorial)))
+	mux.Handle("/exsultet", teamKeys(http.HandlerFunc(gabcHandler.Exsultet)))
+	mux.Handle("/eucharistic", teamKeys(http.HandlerFunc(gabcHandler.Eucharistic)))
+	mux.Handle("/passion", teamKeys(http.HandlerFunc(gabcHandler.Passion)))
+	mux.Handle("/litany", teamKeys(http.HandlerFunc(gabcHandler.Litany)))
+	mux.Handle("/noveritis", teamKeys(http.HandlerFunc(gabcHandler.Noveritis)))
+	mux.Handle("/preview", teamKeys(http.HandlerFunc(gabcHandler.Preview)))
+	mux.Handle("/team/dictionary", teamKeys(http.HandlerFunc(teamHandler.Dictionary)))
+	mux.Handle("/team/dictionary/diff", teamKeys(http.HandlerFunc(teamHandler.DictionaryDiff)))
+
+	// Setup admin routes and metrics, enabled only when a token is given
+	adminHandler := web.NewAdminHandler(generatorAPI)
+	requireToken := web.RequireToken(os.Getenv("ADMIN_TOKEN"))
+	mux.Handle("/debug/vars", requireToken(expvar.Handler()))
+	mux.Handle("/admin/pending", requireToken(http.HandlerFunc(adminHandler.PendingWords)))
+	mux.Handle("/admin/curation", requireToken(http.HandlerFunc(adminHandler.Curation)))
+	mux.Handle("/admin/conflicts", requireToken(http.HandlerFunc(adminHandler.Conflicts)))
+	mux.Handle("/admin/coverage", requireToken(http.HandlerFunc(adminHandler.Coverage)))
 
 	// Initialize http server
 	disableRate := os.Getenv("DISABLE_RATE_LIMIT") == "true"
@@ -61,10 +128,71 @@ func run() error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10*time.Second))
 	defer cancel()
 
-	if err := server.Shutdown(ctx); err != nil {
-		return fmt.Errorf("HTTP shutdown error: %w", err)
+	shutdownErr := server.Shutdown(ctx)
+
+	// Flush the syllables learned since the last save, once no request can add new ones
+	stopPersister()
+	<-persisterDone
+
+	if closer, ok := syllabifier.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			log.Printf("closing syllables database: %v", err)
+		}
+	}
+
+	if shutdownErr != nil {
+		return fmt.Errorf("HTTP shutdown error: %w", shutdownErr)
 	}
 	log.Println("Graceful shutdown complete.")
 
 	return nil
 }
+
+// validateDb runs the same checks made at startup against the syllable database files, so CI can catch invalid entries before deploying.
+// The files can be given in the order liturgical, user and not syllabified; the asset files are checked by default.
+func validateDb(args []string) error {
+	paths := []string{"assets/syllabledatabases/liturgical_syllables.json", "assets/syllabledatabases/user_syllables.json", "assets/syllabledatabases/not_syllabified.txt"}
+	copy(paths, args)
+
+	if err := sitesyllabifier.NewSyllabifier(paths[0], paths[1], paths[2]).LoadSyllables(); err != nil {
+		return fmt.Errorf("validating syllables db files: %w", err)
+	}
+
+	log.Println("syllables db files are valid.")
+
+	return nil
+}
+
+// parseTeamKeys reads the API keys of the teams, given as "key:team" pairs separated by commas.
+func parseTeamKeys(s string) map[string]string {
+	keys := make(map[string]string)
+
+	for pair := range strings.SplitSeq(s, ",") {
+		key, team, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || key == "" || team == "" {
+			continue
+		}
+
+		keys[key] = team
+	}
+
+	return keys
+}
+
+type syllabStore interface {
+	words.Syllabifier
+	persister.DirtySyllabDb
+	SetValidationMode(mode words.ValidationMode)
+}
+
+// newSyllabifier chooses the embedded database when its path is given, or the JSON and TXT files otherwise.
+func newSyllabifier(dbPath string) syllabStore {
+	if dbPath == "" {
+		return sitesyllabifier.NewSyllabifier("assets/syllabledatabases/liturgical_syllables.json", "assets/syllabledatabases/user_syllables.json", "assets/syllabledatabases/not_syllabified.txt")
+	}
+
+	return boltsyllabifier.NewSyllabifier(dbPath, "pt", func(ctx context.Context, word string) (string, int, error) {
+		info, err := sitesyllabifier.FetchSyllabs(ctx, word)
+		return info.Slashed, info.TonicIndex, err
+	})
+}
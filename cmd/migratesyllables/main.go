@@ -0,0 +1,139 @@
+// Command migratesyllables imports the JSON and TXT syllable files into the embedded syllables database, in a single transaction.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/boltsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	dbPath := flag.String("db", "assets/syllabledatabases/syllables.db", "path to the database file, created if it does not exist")
+	language := flag.String("lang", "pt", "language of the imported words")
+	liturgicalPath := flag.String("liturgical", "assets/syllabledatabases/liturgical_syllables.json", "path to the liturgical syllables file")
+	userPath := flag.String("user", "assets/syllabledatabases/user_syllables.json", "path to the user syllables file")
+	notSyllabifiedPath := flag.String("notsyllabified", "assets/syllabledatabases/not_syllabified.txt", "path to the not syllabified words file")
+	lenient := flag.Bool("lenient", false, "skip invalid entries instead of refusing to import")
+	flag.Parse()
+
+	liturgical, err := readJSON(*liturgicalPath)
+	if err != nil {
+		return err
+	}
+
+	user, err := readJSON(*userPath)
+	if err != nil {
+		return err
+	}
+
+	// Validate the entries the same way the sitesyllabifier does at load time
+	invalid := append(invalidEntries(*liturgicalPath, liturgical), invalidEntries(*userPath, user)...)
+	if len(invalid) > 0 {
+		if !*lenient {
+			return &words.ValidationError{Entries: invalid}
+		}
+
+		log.Printf("skipping %v", &words.ValidationError{Entries: invalid})
+	}
+
+	dataNS, err := os.ReadFile(*notSyllabifiedPath)
+	if err != nil {
+		return err
+	}
+
+	db := boltsyllabifier.NewSyllabifier(*dbPath, *language, nil)
+	if *lenient {
+		db.SetValidationMode(words.Lenient)
+	}
+	if err := db.LoadSyllables(); err != nil {
+		return err
+	}
+	defer db.Close()
+
+	now := time.Now().UTC()
+	notSyllabified := 0
+
+	err = db.Update(func(tx *boltsyllabifier.Tx) error {
+		// The whole entries are kept, so homographs carry their candidates along
+		for word, e := range liturgical {
+			e.Source, e.UpdatedAt = words.SourceLiturgical, now
+			if err := tx.Put(*language, boltsyllabifier.StoreLiturgical, word, e); err != nil {
+				return err
+			}
+		}
+
+		for word, e := range user {
+			e.Source, e.UpdatedAt = words.SourceRemote, now
+			if err := tx.Put(*language, boltsyllabifier.StoreUser, word, e); err != nil {
+				return err
+			}
+		}
+
+		for line := range strings.Lines(string(dataNS)) {
+			word := strings.TrimSpace(line)
+			if word == "" {
+				continue
+			}
+
+			if err := tx.MarkNotSyllabified(*language, word, now); err != nil {
+				return err
+			}
+			notSyllabified++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("importing syllables into %v: %w", *dbPath, err)
+	}
+
+	log.Printf("imported %v liturgical, %v user and %v not syllabified words into %v", len(liturgical), len(user), notSyllabified, *dbPath)
+
+	return nil
+}
+
+// invalidEntries removes the invalid entries from a database, returning them.
+func invalidEntries(database string, entries map[string]boltsyllabifier.Entry) []words.InvalidEntry {
+	var invalid []words.InvalidEntry
+
+	for word, e := range entries {
+		for _, c := range append([]boltsyllabifier.Entry{e}, e.Candidates...) { // homograph candidates must spell the same word
+			if err := words.ValidateSyllables(word, c.Slashed, c.TonicIndex); err != nil {
+				invalid = append(invalid, words.InvalidEntry{Database: database, Word: word, Slashed: c.Slashed, TonicIndex: c.TonicIndex, Reason: err})
+				delete(entries, word)
+				break
+			}
+		}
+	}
+
+	return invalid
+}
+
+// readJSON reads a syllables file in the format used by the sitesyllabifier package.
+func readJSON(path string) (map[string]boltsyllabifier.Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries map[string]boltsyllabifier.Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("unmarshaling file %v: %w", path, err)
+	}
+
+	return entries, nil
+}
@@ -6,6 +6,9 @@ require github.com/matryer/is v1.4.1
 
 require (
 	github.com/sergi/go-diff v1.4.0
+	go.etcd.io/bbolt v1.4.3
 	golang.org/x/text v0.26.0
 	golang.org/x/time v0.12.0
 )
+
+require golang.org/x/sys v0.29.0 // indirect
@@ -9,7 +9,58 @@ func (e DomainErr) Error() string {
 	return e.Message
 }
 
+// UnsupportedErr is returned when a dependency does not provide the requested feature.
+type UnsupportedErr struct {
+	Message string
+}
+
+func (e UnsupportedErr) Error() string {
+	return e.Message
+}
+
+// MismatchErr tells that the syllables given for a word do not spell it. It unwraps to ErrSlashedMismatch, so it is handled as a DomainErr.
+type MismatchErr struct {
+	Word    string // the word as it came in the text
+	Slashed string // the syllables given by the syllabifier
+	Reason  string // where the alignment failed
+}
+
+func (e MismatchErr) Error() string {
+	return "syllables \"" + e.Slashed + "\" for word \"" + e.Word + "\": " + e.Reason + ": " + ErrSlashedMismatch.Message
+}
+
+func (e MismatchErr) Unwrap() error {
+	return ErrSlashedMismatch
+}
+
 var ErrShortPhrase = DomainErr{"the phrase is to short to apply the whole melody"}
 var ErrShortParagraph = DomainErr{"each paragraph must have at least three phrases, not counting the conclusion phrase - which can start the last paragraph"}
+var ErrShortOration = DomainErr{"the oration must have at least one phrase before its conclusion formula"}
+var ErrUnknownTone = DomainErr{"the reading tone must be either \"prophecy\", \"epistle\" or \"gospel\""}
+var ErrUnknownPsalmTone = DomainErr{"the psalm tone must be one of \"1\" to \"8\" or \"per\", optionally followed by one of its differentiae, like \"8G\""}
+var ErrUnknownLanguage = DomainErr{"the language must be either \"pt\" or \"la\""}
+var ErrUnknownVerseTone = DomainErr{"the verse tone must be either \"simple\" or \"festive\""}
+var ErrNoStanza = DomainErr{"the responsorial psalm must have at least one stanza after its refrain"}
+var ErrUnknownMinister = DomainErr{"the minister of the Exsultet must be either \"deacon\", \"presbyter\" or \"cantor\""}
+var ErrShortExsultet = DomainErr{"the Exsultet must have an introduction and at least one paragraph after the dialogue"}
+var ErrUnknownPrayer = DomainErr{"the Eucharistic Prayer must be one of \"I\", \"II\", \"III\" or \"IV\""}
+var ErrUnknownPart = DomainErr{"the part of the Eucharistic Prayer must be either \"epiclesis\", \"institution\" or \"doxology\""}
+var ErrNoResponse = DomainErr{"the litany must have a response for its first invocations"}
+var ErrNoInvocation = DomainErr{"each response of the litany must be followed by at least one invocation"}
+var ErrUnknownCanticle = DomainErr{"the canticle must be either \"magnificat\", \"benedictus\" or \"nunc-dimittis\""}
+var ErrCanticleLanguage = DomainErr{"the canticles are only kept in Portuguese, \"pt\""}
+var ErrInvalidYear = DomainErr{"the year must be in the Gregorian calendar, from 1583 on"}
 var ErrNoText = DomainErr{"no incoming text to be parsed"}
 var ErrNoLetters = DomainErr{"non-letter char not attached to any letter"}
+var ErrNoVowel = DomainErr{"a Latin word must have at least one vowel to be syllabified"}
+var ErrSlashedMismatch = DomainErr{"the letters of the slashed syllables do not match the word"}
+var ErrTonicIndex = DomainErr{"the tonic index must point to one of the syllables of the word"}
+var ErrNotPending = DomainErr{"the word is not waiting for review"}
+var ErrNotUserEntry = DomainErr{"the word is not in the user database"}
+var ErrNotLiturgicalEntry = DomainErr{"the word is not in the liturgical database"}
+var ErrUnknownSource = DomainErr{"the source must be either \"user\" or \"liturgical\""}
+var ErrNoReviewQueue = UnsupportedErr{"the syllables database does not keep a review queue"}
+var ErrNoCurator = UnsupportedErr{"the syllables database does not support curation"}
+var ErrInvalidTeam = DomainErr{"the team name must have only lower case letters, digits, \"-\" and \"_\""}
+var ErrNoTeams = UnsupportedErr{"team dictionaries are not enabled"}
+var ErrNoLookup = UnsupportedErr{"the syllables database cannot look words up without fetching them"}
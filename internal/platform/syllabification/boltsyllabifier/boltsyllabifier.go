@@ -0,0 +1,378 @@
+// Package boltsyllabifier is an adapter that keeps the syllables in an embedded bbolt database, fetching unknown words from an external source.
+package boltsyllabifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Stores inside each language bucket. Lookups check the user store before the liturgical one.
+const (
+	StoreLiturgical     = "liturgical"
+	StoreUser           = "user"
+	StoreNotSyllabified = "not_syllabified"
+	storeHistory        = "history" // previous versions of overwritten entries, keyed by store, word and time
+)
+
+var ErrNotOpen = errors.New("syllables database is not open")
+
+// FetchFunc syllabifies a word that is not in the database yet.
+type FetchFunc func(ctx context.Context, word string) (slashed string, tonicIndex int, err error)
+
+type Entry struct {
+	Slashed    string    `json:"slashed"`
+	TonicIndex int       `json:"tonic_index"`
+	Source     string    `json:"source"` // provenance of the entry, one of the words.Source constants
+	UpdatedAt  time.Time `json:"updated_at"`
+	ReviewedAt time.Time `json:"reviewed_at,omitzero"` // when the entry was approved into the liturgical store
+
+	// Homographs keep their default reading above and the other ones as candidates, with hints to choose between them
+	PartOfSpeech string   `json:"part_of_speech,omitempty"`
+	Neighbours   []string `json:"neighbours,omitempty"`
+	Candidates   []Entry  `json:"candidates,omitempty"`
+}
+
+type BoltSyllabifier struct {
+	db          *bolt.DB
+	path        string               // path to the database file
+	language    string               // language used by Syllabify
+	fetch       FetchFunc            // fallback for words not found in the database
+	validation  words.ValidationMode // what to do with invalid entries found while opening the database
+	quarantined []words.InvalidEntry // invalid entries left out of the lookups, found by the last load in Lenient mode
+}
+
+// NewSyllabifier creates a new BoltSyllabifier for the database file at path. The database is opened by LoadSyllables.
+func NewSyllabifier(path, language string, fetch FetchFunc) *BoltSyllabifier {
+	return &BoltSyllabifier{
+		path:     path,
+		language: language,
+		fetch:    fetch,
+	}
+}
+
+// Syllabify syllabifies a word, first checking the user and liturgical stores, then fetching it if not found.
+func (s *BoltSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	entry, ok, err := s.Lookup(s.language, word)
+	if err != nil {
+		return "", 0, fmt.Errorf("syllabifying word: %w", err)
+	}
+
+	if ok {
+		return entry.Slashed, entry.TonicIndex, nil
+	}
+
+	slashed, tonicIndex, err := s.fetch(ctx, word)
+	if err != nil {
+		if errNS := s.AddNotSyllabified(s.language, word); errNS != nil {
+			return "", 0, fmt.Errorf("syllabifying new word: %w", errors.Join(err, errNS))
+		}
+
+		return "", 0, fmt.Errorf("syllabifying new word: %w", err)
+	}
+
+	err = s.Put(s.language, StoreUser, word, Entry{Slashed: slashed, TonicIndex: tonicIndex, Source: words.SourceRemote})
+	if err != nil {
+		return "", 0, fmt.Errorf("syllabifying new word: %w", err)
+	}
+
+	return slashed, tonicIndex, nil
+}
+
+// Candidates returns all known readings of a word, the default one first. Words that are not homographs have a single candidate.
+func (s *BoltSyllabifier) Candidates(ctx context.Context, word string) ([]words.Candidate, error) {
+	entry, ok, err := s.Lookup(s.language, word)
+	if err != nil {
+		return nil, fmt.Errorf("looking up candidates: %w", err)
+	}
+
+	if !ok {
+		slashed, tonicIndex, err := s.Syllabify(ctx, word)
+		if err != nil {
+			return nil, err
+		}
+
+		return []words.Candidate{{Slashed: slashed, TonicIndex: tonicIndex}}, nil
+	}
+
+	candidates := []words.Candidate{entry.candidate()}
+	for _, c := range entry.Candidates {
+		candidates = append(candidates, c.candidate())
+	}
+
+	return candidates, nil
+}
+
+// candidate converts the stored entry into a words.Candidate.
+func (e Entry) candidate() words.Candidate {
+	return words.Candidate{Slashed: e.Slashed, TonicIndex: e.TonicIndex, PartOfSpeech: e.PartOfSpeech, Neighbours: e.Neighbours}
+}
+
+// LoadSyllables opens the database file, creating it if it does not exist, and validates its entries.
+// In Strict mode a database with invalid entries is closed again.
+func (s *BoltSyllabifier) LoadSyllables() error {
+	db, err := bolt.Open(s.path, 0644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return fmt.Errorf("opening syllables database %v: %w", s.path, err)
+	}
+
+	s.db = db
+
+	if err := s.validate(); err != nil {
+		s.db = nil
+		return errors.Join(err, db.Close())
+	}
+
+	return nil
+}
+
+// SaveSyllables has nothing to do, since every change is committed in its own transaction.
+func (s *BoltSyllabifier) SaveSyllables() error {
+	if s.db == nil {
+		return ErrNotOpen
+	}
+
+	return nil
+}
+
+// Dirty always returns 0, since no change waits to be saved.
+func (s *BoltSyllabifier) Dirty() int {
+	return 0
+}
+
+// Close closes the database file.
+func (s *BoltSyllabifier) Close() error {
+	if s.db == nil {
+		return nil
+	}
+
+	return s.db.Close()
+}
+
+// Lookup looks for a word of the given language, first in the user store, then in the liturgical one.
+func (s *BoltSyllabifier) Lookup(language, word string) (Entry, bool, error) {
+	var entry Entry
+	var found bool
+
+	err := s.View(func(tx *Tx) error {
+		for _, store := range []string{StoreUser, StoreLiturgical} {
+			e, ok, err := tx.Get(language, store, word)
+			if err != nil {
+				return err
+			}
+
+			if ok && invalidEntry(word, e) == nil { // invalid entries are only kept in Lenient mode, out of the lookups
+				entry, found = e, true
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return entry, found, err
+}
+
+// Put stores a single entry in its own transaction.
+func (s *BoltSyllabifier) Put(language, store, word string, entry Entry) error {
+	return s.Update(func(tx *Tx) error {
+		return tx.Put(language, store, word, entry)
+	})
+}
+
+// AddNotSyllabified records a word that could not be syllabified.
+func (s *BoltSyllabifier) AddNotSyllabified(language, word string) error {
+	return s.Update(func(tx *Tx) error {
+		return tx.MarkNotSyllabified(language, word, time.Now().UTC())
+	})
+}
+
+// View runs fn inside a read-only transaction.
+func (s *BoltSyllabifier) View(fn func(tx *Tx) error) error {
+	if s.db == nil {
+		return ErrNotOpen
+	}
+
+	return s.db.View(func(btx *bolt.Tx) error {
+		return fn(&Tx{btx: btx})
+	})
+}
+
+// Update runs fn inside a read-write transaction, which is rolled back if fn returns an error.
+func (s *BoltSyllabifier) Update(fn func(tx *Tx) error) error {
+	if s.db == nil {
+		return ErrNotOpen
+	}
+
+	return s.db.Update(func(btx *bolt.Tx) error {
+		return fn(&Tx{btx: btx})
+	})
+}
+
+// Tx is a transaction over the syllables database.
+type Tx struct {
+	btx *bolt.Tx
+}
+
+// Get reads the entry of a word from a store of the given language.
+func (tx *Tx) Get(language, store, word string) (Entry, bool, error) {
+	lang := tx.btx.Bucket([]byte(language))
+	if lang == nil {
+		return Entry{}, false, nil
+	}
+
+	b := lang.Bucket([]byte(store))
+	if b == nil {
+		return Entry{}, false, nil
+	}
+
+	data := b.Get([]byte(word))
+	if data == nil {
+		return Entry{}, false, nil
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return Entry{}, false, fmt.Errorf("unmarshaling entry %v/%v/%v: %w", language, store, word, err)
+	}
+
+	return entry, true, nil
+}
+
+// Put writes the entry of a word into a store of the given language, keeping the previous version in the history.
+// A zero UpdatedAt is set to the current time.
+func (tx *Tx) Put(language, store, word string, entry Entry) error {
+	if entry.UpdatedAt.IsZero() {
+		entry.UpdatedAt = time.Now().UTC()
+	}
+
+	b, err := tx.bucket(language, store)
+	if err != nil {
+		return err
+	}
+
+	if old := b.Get([]byte(word)); old != nil {
+		h, err := tx.bucket(language, storeHistory)
+		if err != nil {
+			return err
+		}
+
+		key := store + "/" + word + "/" + entry.UpdatedAt.Format(time.RFC3339Nano)
+		if err := h.Put([]byte(key), old); err != nil {
+			return fmt.Errorf("keeping history of %v: %w", word, err)
+		}
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshalling entry %v: %w", word, err)
+	}
+
+	if err := b.Put([]byte(word), data); err != nil {
+		return fmt.Errorf("writing entry %v: %w", word, err)
+	}
+
+	return nil
+}
+
+// Delete removes the entry of a word from a store of the given language, keeping it in the history.
+func (tx *Tx) Delete(language, store, word string) error {
+	b, err := tx.bucket(language, store)
+	if err != nil {
+		return err
+	}
+
+	old := b.Get([]byte(word))
+	if old == nil {
+		return nil
+	}
+
+	h, err := tx.bucket(language, storeHistory)
+	if err != nil {
+		return err
+	}
+
+	key := store + "/" + word + "/" + time.Now().UTC().Format(time.RFC3339Nano)
+	if err := h.Put([]byte(key), old); err != nil {
+		return fmt.Errorf("keeping history of %v: %w", word, err)
+	}
+
+	if err := b.Delete([]byte(word)); err != nil {
+		return fmt.Errorf("deleting entry %v: %w", word, err)
+	}
+
+	return nil
+}
+
+// MarkNotSyllabified records a word that could not be syllabified, counting its occurrences and keeping the time it was last seen.
+func (tx *Tx) MarkNotSyllabified(language, word string, at time.Time) error {
+	b, err := tx.bucket(language, StoreNotSyllabified)
+	if err != nil {
+		return err
+	}
+
+	var p pending
+	if data := b.Get([]byte(word)); data != nil {
+		if err := json.Unmarshal(data, &p); err != nil {
+			return fmt.Errorf("unmarshaling not syllabified word %v: %w", word, err)
+		}
+	}
+
+	p.Occurrences++
+	if at.After(p.LastSeen) {
+		p.LastSeen = at
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshalling not syllabified word %v: %w", word, err)
+	}
+
+	if err := b.Put([]byte(word), data); err != nil {
+		return fmt.Errorf("writing not syllabified word %v: %w", word, err)
+	}
+
+	return nil
+}
+
+// ForEach calls fn for each entry of a store of the given language, in lexical order.
+func (tx *Tx) ForEach(language, store string, fn func(word string, entry Entry) error) error {
+	lang := tx.btx.Bucket([]byte(language))
+	if lang == nil {
+		return nil
+	}
+
+	b := lang.Bucket([]byte(store))
+	if b == nil {
+		return nil
+	}
+
+	return b.ForEach(func(k, v []byte) error {
+		var entry Entry
+		if err := json.Unmarshal(v, &entry); err != nil {
+			return fmt.Errorf("unmarshaling entry %v/%v/%s: %w", language, store, k, err)
+		}
+
+		return fn(string(k), entry)
+	})
+}
+
+// bucket returns the bucket of a store inside the language bucket, creating both if needed.
+func (tx *Tx) bucket(language, store string) (*bolt.Bucket, error) {
+	lang, err := tx.btx.CreateBucketIfNotExists([]byte(language))
+	if err != nil {
+		return nil, fmt.Errorf("creating language bucket %v: %w", language, err)
+	}
+
+	b, err := lang.CreateBucketIfNotExists([]byte(store))
+	if err != nil {
+		return nil, fmt.Errorf("creating store bucket %v/%v: %w", language, store, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,146 @@
+package boltsyllabifier_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/boltsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+var ctx context.Context = context.Background()
+
+func TestSyllabify(t *testing.T) {
+	is := is.New(t)
+
+	fetched := 0
+	fetch := func(ctx context.Context, word string) (string, int, error) {
+		fetched++
+		if word == "externo" {
+			return "ex/ter/no", 2, nil
+		}
+		return "", 0, errors.New("no syllables found")
+	}
+
+	syllabifier := boltsyllabifier.NewSyllabifier(filepath.Join(t.TempDir(), "test.db"), "pt", fetch)
+	is.NoErr(syllabifier.LoadSyllables())
+	defer syllabifier.Close()
+
+	is.NoErr(syllabifier.Put("pt", boltsyllabifier.StoreLiturgical, "litúrgicas", boltsyllabifier.Entry{Slashed: "li/túr/gi/cas", TonicIndex: 2, Source: words.SourceLiturgical}))
+
+	t.Run("lookup words that are already at the liturgical store", func(t *testing.T) {
+		is := is.New(t)
+
+		slashed, tonicIndex, err := syllabifier.Syllabify(ctx, "litúrgicas")
+		is.NoErr(err)
+		is.Equal(slashed, "li/túr/gi/cas")
+		is.Equal(tonicIndex, 2)
+		is.Equal(fetched, 0)
+	})
+
+	t.Run("fetch new words once and keep them with their source", func(t *testing.T) {
+		is := is.New(t)
+
+		slashed, tonicIndex, err := syllabifier.Syllabify(ctx, "externo")
+		is.NoErr(err)
+		is.Equal(slashed, "ex/ter/no")
+		is.Equal(tonicIndex, 2)
+
+		_, _, err = syllabifier.Syllabify(ctx, "externo")
+		is.NoErr(err)
+		is.Equal(fetched, 1) // the second lookup hits the user store
+
+		entry, ok, err := syllabifier.Lookup("pt", "externo")
+		is.NoErr(err)
+		is.True(ok)
+		is.Equal(entry.Source, words.SourceRemote)
+		is.True(!entry.UpdatedAt.IsZero())
+	})
+
+	t.Run("lookups are separated by language", func(t *testing.T) {
+		is := is.New(t)
+
+		_, ok, err := syllabifier.Lookup("la", "litúrgicas")
+		is.NoErr(err)
+		is.True(!ok)
+	})
+
+	t.Run("homographs keep all their readings", func(t *testing.T) {
+		is := is.New(t)
+
+		is.NoErr(syllabifier.Put("pt", boltsyllabifier.StoreLiturgical, "sabia", boltsyllabifier.Entry{
+			Slashed: "sa/bi/a", TonicIndex: 2, PartOfSpeech: "verb", Neighbours: []string{"eu", "já"},
+			Candidates: []boltsyllabifier.Entry{{Slashed: "sa/bi/a", TonicIndex: 1, PartOfSpeech: "adjective", Neighbours: []string{"virgem", "mulher"}}},
+		}))
+
+		candidates, err := syllabifier.Candidates(ctx, "sabia")
+		is.NoErr(err)
+		is.Equal(len(candidates), 2)
+		is.Equal(candidates[0].TonicIndex, 2) // the default reading comes first
+		is.Equal(candidates[1].PartOfSpeech, "adjective")
+
+		chosen, certain := words.ChooseCandidate(candidates, words.Context{Before: []string{"ó", "virgem"}})
+		is.True(certain)
+		is.Equal(chosen.TonicIndex, 1)
+	})
+
+	t.Run("failed transactions are rolled back", func(t *testing.T) {
+		is := is.New(t)
+
+		err := syllabifier.Update(func(tx *boltsyllabifier.Tx) error {
+			if err := tx.Put("pt", boltsyllabifier.StoreUser, "perdido", boltsyllabifier.Entry{Slashed: "per/di/do", TonicIndex: 2}); err != nil {
+				return err
+			}
+			return errors.New("abort")
+		})
+		is.True(err != nil)
+
+		_, ok, err := syllabifier.Lookup("pt", "perdido")
+		is.NoErr(err)
+		is.True(!ok)
+	})
+}
+
+func TestValidation(t *testing.T) {
+	is := is.New(t)
+
+	path := filepath.Join(t.TempDir(), "test.db")
+	db := boltsyllabifier.NewSyllabifier(path, "pt", nil)
+	is.NoErr(db.LoadSyllables())
+	is.NoErr(db.Put("pt", boltsyllabifier.StoreLiturgical, "litúrgicas", boltsyllabifier.Entry{Slashed: "li/túr/gi/cas", TonicIndex: 2}))
+	is.NoErr(db.Put("pt", boltsyllabifier.StoreUser, "errado", boltsyllabifier.Entry{Slashed: "er/ra/dos", TonicIndex: 2}))
+	is.NoErr(db.Put("pt", boltsyllabifier.StoreUser, "sabia", boltsyllabifier.Entry{Slashed: "sa/bi/a", TonicIndex: 2, Candidates: []boltsyllabifier.Entry{{Slashed: "sa/bi/a", TonicIndex: 4}}}))
+	is.NoErr(db.Close())
+
+	t.Run("refuse to load databases with invalid entries in strict mode", func(t *testing.T) {
+		is := is.New(t)
+
+		syllabifier := boltsyllabifier.NewSyllabifier(path, "pt", nil)
+		err := syllabifier.LoadSyllables()
+		var validationErr *words.ValidationError
+		is.True(errors.As(err, &validationErr))
+		is.Equal(len(validationErr.Entries), 2) // invalid homograph candidates count too
+		is.Equal(validationErr.Entries[0].Word, "errado")
+	})
+
+	t.Run("quarantine the invalid entries in lenient mode", func(t *testing.T) {
+		is := is.New(t)
+
+		syllabifier := boltsyllabifier.NewSyllabifier(path, "pt", nil)
+		syllabifier.SetValidationMode(words.Lenient)
+		is.NoErr(syllabifier.LoadSyllables())
+		defer syllabifier.Close()
+		is.Equal(len(syllabifier.Quarantined()), 2)
+
+		_, ok, err := syllabifier.Lookup("pt", "errado")
+		is.NoErr(err)
+		is.True(!ok) // quarantined entries are left out of the lookups
+
+		_, ok, err = syllabifier.Lookup("pt", "litúrgicas")
+		is.NoErr(err)
+		is.True(ok)
+	})
+}
@@ -0,0 +1,153 @@
+// Package boltsyllabifier is an adapter that keeps the syllables in an embedded bbolt database, fetching unknown words from an external source.
+package boltsyllabifier
+
+import (
+	"fmt"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// UnreviewedEntries lists the entries of the user store, which were never approved into the liturgical one.
+func (s *BoltSyllabifier) UnreviewedEntries() ([]words.Entry, error) {
+	var entries []words.Entry
+
+	err := s.View(func(tx *Tx) error {
+		return tx.ForEach(s.language, StoreUser, func(word string, e Entry) error {
+			entries = append(entries, e.entry(word))
+			return nil
+		})
+	})
+
+	return entries, err
+}
+
+// ApproveEntry moves a user entry into the liturgical store, in a single transaction. An empty slashed form approves the entry as it is.
+func (s *BoltSyllabifier) ApproveEntry(word, slashed string, tonicIndex int) error {
+	return s.Update(func(tx *Tx) error {
+		return tx.approve(s.language, word, slashed, tonicIndex)
+	})
+}
+
+// Conflicts lists the words found in both stores with different syllables or tonic index.
+func (s *BoltSyllabifier) Conflicts() ([]words.Conflict, error) {
+	var conflicts []words.Conflict
+
+	err := s.View(func(tx *Tx) error {
+		return tx.ForEach(s.language, StoreUser, func(word string, user Entry) error {
+			liturgical, ok, err := tx.Get(s.language, StoreLiturgical, word)
+			if err != nil || !ok || (liturgical.Slashed == user.Slashed && liturgical.TonicIndex == user.TonicIndex) {
+				return err
+			}
+
+			conflicts = append(conflicts, words.Conflict{Word: word, User: user.entry(word), Liturgical: liturgical.entry(word)})
+			return nil
+		})
+	})
+
+	return conflicts, err
+}
+
+// ResolveConflict keeps the syllabification of one of the stores. Keeping the user one approves it into the liturgical store.
+// Either way the user entry is dropped, since the liturgical store now holds the right answer.
+func (s *BoltSyllabifier) ResolveConflict(word, keep string) error {
+	switch keep {
+	case words.SourceUser:
+		return s.ApproveEntry(word, "", 0)
+
+	case words.SourceLiturgical:
+		return s.Update(func(tx *Tx) error {
+			if _, ok, err := tx.Get(s.language, StoreLiturgical, word); err != nil || !ok {
+				return fmt.Errorf("resolving conflict of %v: %w", word, gabcErrors.ErrNotLiturgicalEntry)
+			}
+
+			if _, ok, err := tx.Get(s.language, StoreUser, word); err != nil || !ok {
+				return fmt.Errorf("resolving conflict of %v: %w", word, gabcErrors.ErrNotUserEntry)
+			}
+
+			return tx.Delete(s.language, StoreUser, word)
+		})
+
+	default:
+		return fmt.Errorf("resolving conflict of %v: keeping %q: %w", word, keep, gabcErrors.ErrUnknownSource)
+	}
+}
+
+// approve moves a user entry into the liturgical store.
+func (tx *Tx) approve(language, word, slashed string, tonicIndex int) error {
+	e, ok, err := tx.Get(language, StoreUser, word)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("approving %v: %w", word, gabcErrors.ErrNotUserEntry)
+	}
+
+	if slashed != "" {
+		e.Slashed, e.TonicIndex = slashed, tonicIndex
+		e.Source = words.SourceUser
+	}
+
+	if err := words.ValidateSyllables(word, e.Slashed, e.TonicIndex); err != nil {
+		return err
+	}
+
+	e.ReviewedAt = time.Now().UTC()
+
+	if err := tx.Put(language, StoreLiturgical, word, e); err != nil {
+		return err
+	}
+
+	return tx.Delete(language, StoreUser, word)
+}
+
+// entry converts the stored entry into a words.Entry.
+func (e Entry) entry(word string) words.Entry {
+	return words.Entry{
+		Word:       word,
+		Slashed:    e.Slashed,
+		TonicIndex: e.TonicIndex,
+		Source:     e.Source,
+		UpdatedAt:  e.UpdatedAt,
+		ReviewedAt: e.ReviewedAt,
+	}
+}
+
+// LookupEntry looks a word of the syllabifier language up, without fetching it when it is unknown.
+func (s *BoltSyllabifier) LookupEntry(word string) (words.Entry, string, error) {
+	var entry words.Entry
+	var database string
+
+	err := s.View(func(tx *Tx) error {
+		for _, store := range []string{StoreUser, StoreLiturgical} {
+			e, ok, err := tx.Get(s.language, store, word)
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				entry, database = e.entry(word), store // the stores are named after the databases
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return entry, database, err
+}
+
+// InLiturgical tells whether the liturgical store has a word of the syllabifier language.
+func (s *BoltSyllabifier) InLiturgical(word string) (bool, error) {
+	var found bool
+
+	err := s.View(func(tx *Tx) error {
+		_, ok, err := tx.Get(s.language, StoreLiturgical, word)
+		found = ok
+		return err
+	})
+
+	return found, err
+}
@@ -0,0 +1,90 @@
+// Package boltsyllabifier is an adapter that keeps the syllables in an embedded bbolt database, fetching unknown words from an external source.
+package boltsyllabifier
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// pending is how a not syllabified word is stored.
+type pending struct {
+	Occurrences int       `json:"occurrences"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// PendingWords lists the words of the syllabifier language that could not be syllabified, the most frequent first.
+func (s *BoltSyllabifier) PendingWords() ([]words.PendingWord, error) {
+	var list []words.PendingWord
+
+	err := s.View(func(tx *Tx) error {
+		lang := tx.btx.Bucket([]byte(s.language))
+		if lang == nil {
+			return nil
+		}
+
+		b := lang.Bucket([]byte(StoreNotSyllabified))
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			var p pending
+			if err := json.Unmarshal(v, &p); err != nil {
+				return fmt.Errorf("unmarshaling not syllabified word %s: %w", k, err)
+			}
+
+			list = append(list, words.PendingWord{Word: string(k), Occurrences: p.Occurrences, LastSeen: p.LastSeen})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Occurrences > list[j].Occurrences
+	})
+
+	return list, nil
+}
+
+// ResolvePending validates the reviewed syllables of a pending word and moves it into the user store, in a single transaction.
+func (s *BoltSyllabifier) ResolvePending(word, slashed string, tonicIndex int) error {
+	if err := words.ValidateSyllables(word, slashed, tonicIndex); err != nil {
+		return err
+	}
+
+	return s.Update(func(tx *Tx) error {
+		if err := tx.removePending(s.language, word); err != nil {
+			return err
+		}
+
+		return tx.Put(s.language, StoreUser, word, Entry{Slashed: slashed, TonicIndex: tonicIndex, Source: words.SourceUser})
+	})
+}
+
+// DismissPending removes a word from the queue without syllabifying it.
+func (s *BoltSyllabifier) DismissPending(word string) error {
+	return s.Update(func(tx *Tx) error {
+		return tx.removePending(s.language, word)
+	})
+}
+
+// removePending deletes a word from the not syllabified store, failing if it is not there.
+func (tx *Tx) removePending(language, word string) error {
+	b, err := tx.bucket(language, StoreNotSyllabified)
+	if err != nil {
+		return err
+	}
+
+	if b.Get([]byte(word)) == nil {
+		return gabcErrors.ErrNotPending
+	}
+
+	return b.Delete([]byte(word))
+}
@@ -0,0 +1,71 @@
+package boltsyllabifier
+
+import (
+	"log"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SetValidationMode chooses what LoadSyllables does with invalid entries. The default is Strict.
+func (s *BoltSyllabifier) SetValidationMode(mode words.ValidationMode) {
+	s.validation = mode
+}
+
+// Quarantined returns the invalid entries left out by the last load in Lenient mode.
+func (s *BoltSyllabifier) Quarantined() []words.InvalidEntry {
+	return s.quarantined
+}
+
+// validate checks every entry of the user and liturgical stores of every language. In Strict mode all invalid entries are returned together
+// in a words.ValidationError, in Lenient mode they are logged and stay in the database, where Lookup leaves them out.
+func (s *BoltSyllabifier) validate() error {
+	var invalid []words.InvalidEntry
+
+	err := s.View(func(tx *Tx) error {
+		return tx.btx.ForEach(func(language []byte, _ *bolt.Bucket) error {
+			for _, store := range []string{StoreLiturgical, StoreUser} {
+				err := tx.ForEach(string(language), store, func(word string, entry Entry) error {
+					if err := invalidEntry(word, entry); err != nil {
+						invalid = append(invalid, words.InvalidEntry{Database: s.path + ":" + string(language) + "/" + store, Word: word, Slashed: entry.Slashed, TonicIndex: entry.TonicIndex, Reason: err})
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.quarantined = nil
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	if s.validation == words.Strict {
+		return &words.ValidationError{Entries: invalid}
+	}
+
+	s.quarantined = invalid
+	log.Printf("quarantined %v", &words.ValidationError{Entries: invalid})
+
+	return nil
+}
+
+// invalidEntry tells why an entry or one of its homograph candidates does not spell its word, or nil when it is valid.
+func invalidEntry(word string, entry Entry) error {
+	for _, c := range append([]Entry{entry}, entry.Candidates...) {
+		if err := words.ValidateSyllables(word, c.Slashed, c.TonicIndex); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Package latinsyllabifier is an adapter that syllabifies Latin words by the rules of ecclesiastical Latin, with no database behind it.
+package latinsyllabifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+)
+
+type LatinSyllabifier struct {
+}
+
+// NewSyllabifier creates a new LatinSyllabifier instance.
+func NewSyllabifier() *LatinSyllabifier {
+	return &LatinSyllabifier{}
+}
+
+const (
+	vowels   = "aeiouyæœáéíóúýǽë"
+	accented = "áéíóúýǽ" // the liturgical books mark the accent of the words with more than two syllables
+	mutes    = "bcdfgpt"
+	liquids  = "lr"
+)
+
+// Syllabify splits a lower case Latin word into syllables, returning them with slashes and the index of the tonic one, beginning with 1.
+// The tonic syllable is the one with an accent mark; words without it are stressed on the first syllable when they have two,
+// and on the penultimate one when they have more, as the accent is only left unmarked in the books when it falls there.
+func (syllab LatinSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	letters := []rune(word)
+	nuclei := findNuclei(letters)
+
+	if len(nuclei) == 0 {
+		return "", 0, fmt.Errorf("syllabifying Latin word %q: %w", word, gabcErrors.ErrNoVowel)
+	}
+
+	// Each syllable but the first starts where the consonants between its nucleus and the previous one are split
+	var syllables []string
+	start := 0
+	for k := 1; k < len(nuclei); k++ {
+		split := splitConsonants(letters, nuclei[k-1].end, nuclei[k].start)
+		syllables = append(syllables, string(letters[start:split]))
+		start = split
+	}
+	syllables = append(syllables, string(letters[start:]))
+
+	return strings.Join(syllables, "/"), tonic(syllables), nil
+}
+
+// nucleus is the vowel, or the diphthong, of a syllable, from start up to end, not included.
+type nucleus struct {
+	start, end int
+}
+
+// findNuclei finds the vowels that make syllables, joining the diphthongs and leaving out the "i" and "u" that sound as consonants.
+func findNuclei(letters []rune) []nucleus {
+	var nuclei []nucleus
+
+	for i := 0; i < len(letters); i++ {
+		if !isVowel(letters, i) {
+			continue
+		}
+
+		if n := len(nuclei); n > 0 && nuclei[n-1].end == i && diphthong(letters[i-1], letters[i]) {
+			nuclei[n-1].end = i + 1
+			continue
+		}
+
+		nuclei = append(nuclei, nucleus{start: i, end: i + 1})
+	}
+
+	return nuclei
+}
+
+// isVowel tells whether the letter at i is sung as a vowel. The "u" of "qu", and of "gu" after "n" before a vowel, as in "sanguis",
+// sounds as a consonant, and so does the "i" at the beginning of a word or between vowels, as in "Iesu" and "eius".
+func isVowel(letters []rune, i int) bool {
+	r := letters[i]
+	if !strings.ContainsRune(vowels, r) {
+		return false
+	}
+
+	next := i+1 < len(letters) && strings.ContainsRune(vowels, letters[i+1])
+
+	switch r {
+	case 'u':
+		if i > 0 && letters[i-1] == 'q' {
+			return false
+		}
+		if i > 1 && letters[i-1] == 'g' && letters[i-2] == 'n' && next {
+			return false
+		}
+	case 'i':
+		if next && (i == 0 || isVowel(letters, i-1)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// diphthong tells whether two vowels are sung in the same syllable, as "ae", "oe" and "au".
+func diphthong(first, second rune) bool {
+	switch first {
+	case 'a', 'á':
+		return second == 'e' || second == 'u'
+	case 'o', 'ó':
+		return second == 'e'
+	}
+
+	return false
+}
+
+// splitConsonants returns where the consonants between two nuclei are split: a single consonant begins the next syllable,
+// a mute followed by a liquid, as in "patri", begins it together, and of the other groups only the last consonant begins it.
+// An "x" stays in the previous syllable, as in "ex/sul/tet", and an "h" after a consonant goes along with it, as in "Pas/cha".
+func splitConsonants(letters []rune, from, to int) int {
+	var units []int // where each consonant sound begins
+	for i := from; i < to; i++ {
+		if letters[i] == 'h' && i > from || letters[i] == 'u' && i > from && (letters[i-1] == 'q' || letters[i-1] == 'g') {
+			continue
+		}
+		units = append(units, i)
+	}
+
+	switch {
+	case len(units) == 0:
+		return to
+	case letters[units[0]] == 'x':
+		if len(units) == 1 {
+			return to
+		}
+		return units[1]
+	case len(units) == 1:
+		return units[0]
+	}
+
+	last := units[len(units)-1]
+	beforeLast := units[len(units)-2]
+	if strings.ContainsRune(mutes, letters[beforeLast]) && strings.ContainsRune(liquids, letters[last]) && last-beforeLast <= 2 {
+		return beforeLast
+	}
+
+	return last
+}
+
+// tonic returns the index of the tonic syllable, beginning with 1.
+func tonic(syllables []string) int {
+	for i, s := range syllables {
+		if strings.ContainsAny(s, accented) {
+			return i + 1
+		}
+	}
+
+	if len(syllables) < 3 {
+		return 1
+	}
+
+	return len(syllables) - 1
+}
+
+func (syllab LatinSyllabifier) LoadSyllables() error {
+	// The rules need no database to be loaded
+	return nil
+}
+
+func (syllab LatinSyllabifier) SaveSyllables() error {
+	// The rules need no database to be saved
+	return nil
+}
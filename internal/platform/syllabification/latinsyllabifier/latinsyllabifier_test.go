@@ -0,0 +1,57 @@
+package latinsyllabifier_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/latinsyllabifier"
+)
+
+var ctx context.Context = context.Background()
+
+func TestSyllabify(t *testing.T) {
+	syllabifier := latinsyllabifier.NewSyllabifier()
+
+	for _, c := range []struct {
+		word    string
+		slashed string
+		tonic   int
+	}{
+		{"est", "est", 1},
+		{"patri", "pa/tri", 1},                  // a mute followed by a liquid begins the syllable
+		{"sǽcula", "sǽ/cu/la", 1},               // the accent mark gives the tonic syllable
+		{"sæculórum", "sæ/cu/ló/rum", 3},        // and so does it after a ligature
+		{"omnipoténti", "om/ni/po/tén/ti", 4},   // of the other groups, only the last consonant begins it
+		{"sanctus", "sanc/tus", 1},              // a word of two syllables is stressed on the first one
+		{"gáudium", "gáu/di/um", 1},             // "au" is a diphthong
+		{"eius", "e/ius", 1},                    // the "i" between vowels sounds as a consonant
+		{"iesu", "ie/su", 1},                    // and so does it at the beginning of a word
+		{"ieiúnii", "ie/iú/ni/i", 2},            // both at once
+		{"quadragésimæ", "qua/dra/gé/si/mæ", 3}, // the "u" of "qu" sounds as a consonant
+		{"sánguinem", "sán/gui/nem", 1},         // and so does it in "ngu"
+		{"pascha", "pas/cha", 1},                // "ch" is a single consonant
+		{"exsultet", "ex/sul/tet", 2},           // the "x" stays in the previous syllable
+		{"septémbris", "sep/tém/bris", 2},
+		{"resurrectióne", "re/sur/rec/ti/ó/ne", 5},
+		{"annuntiamus", "an/nun/ti/a/mus", 4}, // unmarked words are stressed on the penultimate syllable
+	} {
+		t.Run(c.word, func(t *testing.T) {
+			is := is.New(t)
+
+			slashed, tonic, err := syllabifier.Syllabify(ctx, c.word)
+			is.NoErr(err)
+			is.Equal(slashed, c.slashed)
+			is.Equal(tonic, c.tonic)
+		})
+	}
+
+	t.Run("a word needs a vowel", func(t *testing.T) {
+		is := is.New(t)
+
+		_, _, err := syllabifier.Syllabify(ctx, "xp")
+		is.True(errors.Is(err, gabcErrors.ErrNoVowel))
+	})
+}
@@ -0,0 +1,107 @@
+// Package persister saves the syllables learned during runtime in the background, so requests don't wait for disk writes.
+package persister
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"time"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// Metrics published at /debug/vars, behind the admin token.
+var (
+	flushes       = expvar.NewInt("syllables_flushes")
+	flushErrors   = expvar.NewInt("syllables_flush_errors")
+	flushedWords  = expvar.NewInt("syllables_flushed_changes")
+	pendingWords  = expvar.NewInt("syllables_pending_changes")
+	lastFlushUnix = expvar.NewInt("syllables_last_flush_unix")
+)
+
+// DirtySyllabDb is a syllables database that knows how many of its changes were not saved yet.
+type DirtySyllabDb interface {
+	words.SyllabDb
+	Dirty() int // number of changes made since the last save
+}
+
+type Config struct {
+	Interval   time.Duration // maximum time a change may stay unsaved
+	MaxPending int           // number of unsaved changes that triggers a flush before the interval ends
+	CheckEvery time.Duration // how often the dirty state is checked
+}
+
+type Persister struct {
+	db        DirtySyllabDb
+	cfg       Config
+	lastFlush time.Time
+}
+
+// New creates a new Persister for the given database. Zero values in the config are replaced by defaults.
+func New(db DirtySyllabDb, cfg Config) *Persister {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+
+	if cfg.MaxPending <= 0 {
+		cfg.MaxPending = 20
+	}
+
+	if cfg.CheckEvery <= 0 || cfg.CheckEvery > cfg.Interval {
+		cfg.CheckEvery = time.Second
+	}
+
+	return &Persister{
+		db:  db,
+		cfg: cfg,
+	}
+}
+
+// Run checks the dirty state of the database until the context is done, flushing it when the interval has passed or too many changes are pending.
+// It flushes once more before returning, so it must be waited on during a graceful shutdown.
+func (p *Persister) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.CheckEvery)
+	defer ticker.Stop()
+
+	p.lastFlush = time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if p.db.Dirty() > 0 {
+				p.Flush()
+			}
+			return
+
+		case now := <-ticker.C:
+			pending := p.db.Dirty()
+			pendingWords.Set(int64(pending))
+
+			if pending == 0 { // nothing to save, so the interval only starts counting with the first new change
+				p.lastFlush = now
+				continue
+			}
+
+			if pending >= p.cfg.MaxPending || now.Sub(p.lastFlush) >= p.cfg.Interval {
+				p.Flush()
+			}
+		}
+	}
+}
+
+// Flush saves the database right away. Errors are logged and counted instead of returned, since no request is waiting for them.
+func (p *Persister) Flush() {
+	pending := p.db.Dirty()
+	p.lastFlush = time.Now()
+
+	if err := p.db.SaveSyllables(); err != nil {
+		flushErrors.Add(1)
+		log.Printf("flushing syllables: %v", err)
+		return
+	}
+
+	flushes.Add(1)
+	flushedWords.Add(int64(pending))
+	pendingWords.Set(int64(p.db.Dirty()))
+	lastFlushUnix.Set(p.lastFlush.Unix())
+}
@@ -0,0 +1,105 @@
+package persister_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/persister"
+)
+
+type fakeDb struct {
+	mu      sync.Mutex
+	dirty   int
+	saves   int
+	saveErr error
+}
+
+func (db *fakeDb) LoadSyllables() error { return nil }
+
+func (db *fakeDb) SaveSyllables() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.saveErr != nil {
+		return db.saveErr
+	}
+
+	db.saves++
+	db.dirty = 0
+
+	return nil
+}
+
+func (db *fakeDb) Dirty() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.dirty
+}
+
+func (db *fakeDb) learn(n int) {
+	db.mu.Lock()
+	db.dirty += n
+	db.mu.Unlock()
+}
+
+func (db *fakeDb) savesCount() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.saves
+}
+
+func TestPersister(t *testing.T) {
+	t.Run("flushes after N new words without waiting for the interval", func(t *testing.T) {
+		is := is.New(t)
+		db := &fakeDb{}
+		p := persister.New(db, persister.Config{Interval: time.Hour, MaxPending: 3, CheckEvery: time.Millisecond})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() { p.Run(ctx); close(done) }()
+
+		db.learn(2)
+		time.Sleep(20 * time.Millisecond)
+		is.Equal(db.savesCount(), 0) // below the threshold
+
+		db.learn(1)
+		time.Sleep(20 * time.Millisecond)
+		is.Equal(db.savesCount(), 1)
+
+		cancel()
+		<-done
+		is.Equal(db.savesCount(), 1) // nothing dirty, so no flush at shutdown
+	})
+
+	t.Run("flushes pending words on shutdown", func(t *testing.T) {
+		is := is.New(t)
+		db := &fakeDb{}
+		p := persister.New(db, persister.Config{Interval: time.Hour, MaxPending: 100, CheckEvery: time.Millisecond})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() { p.Run(ctx); close(done) }()
+
+		db.learn(1)
+		cancel()
+		<-done
+		is.Equal(db.savesCount(), 1)
+		is.Equal(db.Dirty(), 0)
+	})
+
+	t.Run("flush errors keep the changes dirty", func(t *testing.T) {
+		is := is.New(t)
+		db := &fakeDb{saveErr: errors.New("disk full")}
+		p := persister.New(db, persister.Config{})
+
+		db.learn(1)
+		p.Flush()
+		is.Equal(db.Dirty(), 1)
+	})
+}
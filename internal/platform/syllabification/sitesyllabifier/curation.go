@@ -0,0 +1,142 @@
+// Package sitesyllabifier is an adapter that fetches syllables from an external website.
+package sitesyllabifier
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// UnreviewedEntries lists the entries of the user database, which were never approved into the liturgical one, sorted by word.
+func (s *SiteSyllabifier) UnreviewedEntries() ([]words.Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]words.Entry, 0, len(s.userSyllabs))
+	for word, info := range s.userSyllabs {
+		entries = append(entries, info.entry(word))
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Word < entries[j].Word })
+
+	return entries, nil
+}
+
+// ApproveEntry moves a user entry into the liturgical database. An empty slashed form approves the entry as it is.
+func (s *SiteSyllabifier) ApproveEntry(word, slashed string, tonicIndex int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, ok := s.userSyllabs[word]
+	if !ok {
+		return fmt.Errorf("approving %v: %w", word, gabcErrors.ErrNotUserEntry)
+	}
+
+	if slashed != "" {
+		info.Slashed, info.TonicIndex = slashed, tonicIndex
+		info.Source = words.SourceUser
+	}
+
+	if err := words.ValidateSyllables(word, info.Slashed, info.TonicIndex); err != nil {
+		return err
+	}
+
+	info.ReviewedAt = time.Now().UTC()
+	s.liturgicalSyllabs[word] = info
+	delete(s.userSyllabs, word)
+	s.liturgicalChanged = true
+	s.dirty++
+
+	return nil
+}
+
+// Conflicts lists the words found in both databases with different syllables or tonic index, sorted by word.
+func (s *SiteSyllabifier) Conflicts() ([]words.Conflict, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var conflicts []words.Conflict
+
+	for word, user := range s.userSyllabs {
+		liturgical, ok := s.liturgicalSyllabs[word]
+		if !ok || (liturgical.Slashed == user.Slashed && liturgical.TonicIndex == user.TonicIndex) {
+			continue
+		}
+
+		conflicts = append(conflicts, words.Conflict{Word: word, User: user.entry(word), Liturgical: liturgical.entry(word)})
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Word < conflicts[j].Word })
+
+	return conflicts, nil
+}
+
+// ResolveConflict keeps the syllabification of one of the databases. Keeping the user one approves it into the liturgical database.
+// Either way the user entry is dropped, since the liturgical database now holds the right answer.
+func (s *SiteSyllabifier) ResolveConflict(word, keep string) error {
+	switch keep {
+	case words.SourceUser:
+		return s.ApproveEntry(word, "", 0)
+
+	case words.SourceLiturgical:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.liturgicalSyllabs[word]; !ok {
+			return fmt.Errorf("resolving conflict of %v: %w", word, gabcErrors.ErrNotLiturgicalEntry)
+		}
+
+		if _, ok := s.userSyllabs[word]; !ok {
+			return fmt.Errorf("resolving conflict of %v: %w", word, gabcErrors.ErrNotUserEntry)
+		}
+
+		delete(s.userSyllabs, word)
+		s.dirty++
+
+		return nil
+
+	default:
+		return fmt.Errorf("resolving conflict of %v: keeping %q: %w", word, keep, gabcErrors.ErrUnknownSource)
+	}
+}
+
+// entry converts the stored info into a words.Entry.
+func (info SyllableInfo) entry(word string) words.Entry {
+	return words.Entry{
+		Word:       word,
+		Slashed:    info.Slashed,
+		TonicIndex: info.TonicIndex,
+		Source:     info.Source,
+		UpdatedAt:  info.UpdatedAt,
+		ReviewedAt: info.ReviewedAt,
+	}
+}
+
+// LookupEntry looks a word up in the user database, then in the liturgical one, without fetching it when it is unknown.
+func (s *SiteSyllabifier) LookupEntry(word string) (words.Entry, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if info, ok := s.userSyllabs[word]; ok {
+		return info.entry(word), words.SourceUser, nil
+	}
+
+	if info, ok := s.liturgicalSyllabs[word]; ok {
+		return info.entry(word), words.SourceLiturgical, nil
+	}
+
+	return words.Entry{}, "", nil
+}
+
+// InLiturgical tells whether the liturgical database has a word.
+func (s *SiteSyllabifier) InLiturgical(word string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.liturgicalSyllabs[word]
+
+	return ok, nil
+}
@@ -0,0 +1,122 @@
+// Package sitesyllabifier is an adapter that fetches syllables from an external website.
+package sitesyllabifier
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// PendingWords lists the words that could not be syllabified, the most frequent first.
+func (s *SiteSyllabifier) PendingWords() ([]words.PendingWord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pending := make([]words.PendingWord, 0, len(s.notSyllabified))
+	for _, p := range s.notSyllabified {
+		pending = append(pending, p)
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].Occurrences != pending[j].Occurrences {
+			return pending[i].Occurrences > pending[j].Occurrences
+		}
+		return pending[i].Word < pending[j].Word
+	})
+
+	return pending, nil
+}
+
+// ResolvePending validates the reviewed syllables of a pending word, adds them to the user database and removes the word from the queue.
+func (s *SiteSyllabifier) ResolvePending(word, slashed string, tonicIndex int) error {
+	if err := words.ValidateSyllables(word, slashed, tonicIndex); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.notSyllabified[word]; !ok {
+		return gabcErrors.ErrNotPending
+	}
+
+	s.userSyllabs[word] = SyllableInfo{Slashed: slashed, TonicIndex: tonicIndex, Source: words.SourceUser, UpdatedAt: time.Now().UTC()}
+	delete(s.notSyllabified, word)
+	s.dirty++
+
+	return nil
+}
+
+// DismissPending removes a word from the queue without syllabifying it.
+func (s *SiteSyllabifier) DismissPending(word string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.notSyllabified[word]; !ok {
+		return gabcErrors.ErrNotPending
+	}
+
+	delete(s.notSyllabified, word)
+	s.dirty++
+
+	return nil
+}
+
+// parseNotSyllabified reads the not syllabified words file. Each line holds a word, its occurrences and when it was last seen, separated by tabs.
+// Lines holding just the word, as written by older versions, count as a single occurrence.
+func parseNotSyllabified(data string) map[string]words.PendingWord {
+	pending := make(map[string]words.PendingWord)
+
+	for line := range strings.Lines(data) {
+		fields := strings.Split(strings.TrimSpace(line), "\t")
+		if fields[0] == "" {
+			continue
+		}
+
+		p := pending[fields[0]]
+		p.Word = fields[0]
+		occurrences := 1
+
+		if len(fields) > 1 {
+			if n, err := strconv.Atoi(fields[1]); err == nil && n > 0 {
+				occurrences = n
+			}
+		}
+
+		if len(fields) > 2 {
+			if t, err := time.Parse(time.RFC3339, fields[2]); err == nil && t.After(p.LastSeen) {
+				p.LastSeen = t
+			}
+		}
+
+		p.Occurrences += occurrences
+		pending[p.Word] = p
+	}
+
+	return pending
+}
+
+// formatNotSyllabified writes the not syllabified words in the format read by parseNotSyllabified, sorted by word.
+func formatNotSyllabified(pending map[string]words.PendingWord) string {
+	keys := make([]string, 0, len(pending))
+	for k := range pending {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		p := pending[k]
+		lastSeen := ""
+		if !p.LastSeen.IsZero() {
+			lastSeen = p.LastSeen.Format(time.RFC3339)
+		}
+		b.WriteString(p.Word + "\t" + strconv.Itoa(p.Occurrences) + "\t" + lastSeen + "\n")
+	}
+
+	return b.String()
+}
@@ -10,15 +10,26 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
 )
 
 type SiteSyllabifier struct {
-	userSyllabs            map[string]SyllableInfo // map of user syllables, loaded from a file and new words added during runtime
-	userFilePath           string                  // path to the user syllables file
-	liturgicalSyllabs      map[string]SyllableInfo // map of liturgical syllables, loaded from a file
-	liturgicalFilePath     string                  // path to the liturgical syllables file
-	notSyllabifiedWords    string                  // list of words that were not syllabified, to be saved to a file later
-	notSyllabifiedFilePath string                  // path to the file where the not syllabified words will be saved
+	mu                     sync.RWMutex                 // guards the maps and lists below, which are shared between requests and the background persister
+	userSyllabs            map[string]SyllableInfo      // map of user syllables, loaded from a file and new words added during runtime
+	userFilePath           string                       // path to the user syllables file
+	liturgicalSyllabs      map[string]SyllableInfo      // map of liturgical syllables, loaded from a file
+	liturgicalFilePath     string                       // path to the liturgical syllables file
+	notSyllabified         map[string]words.PendingWord // words that were not syllabified, waiting for review and to be saved to a file later
+	notSyllabifiedFilePath string                       // path to the file where the not syllabified words will be saved
+	dirty                  int                          // number of changes made since the last save
+	liturgicalChanged      bool                         // whether the liturgical syllables must be saved too
+	validation             words.ValidationMode         // what to do with invalid entries found while loading
+	quarantinedUser        map[string]SyllableInfo      // invalid user entries, left out of the lookups but kept in the file
+	quarantinedLiturgical  map[string]SyllableInfo      // invalid liturgical entries, left out of the lookups but kept in the file
+	quarantined            []words.InvalidEntry         // reasons of the quarantined entries
 }
 
 // NewSyllabifier creates a new SiteSyllabifier instance.
@@ -31,38 +42,98 @@ func NewSyllabifier(liturgicalSyllabsPath, userSyllabsPath, notSyllabifiedPath s
 }
 
 type SyllableInfo struct {
-	Slashed    string `json:"slashed"`
-	TonicIndex int    `json:"tonic_index"`
+	Slashed    string    `json:"slashed"`
+	TonicIndex int       `json:"tonic_index"`
+	Source     string    `json:"source,omitempty"`     // provenance of the entry, one of the words.Source constants
+	UpdatedAt  time.Time `json:"updated_at,omitzero"`  // when the entry was stored
+	ReviewedAt time.Time `json:"reviewed_at,omitzero"` // when the entry was approved into the liturgical database
+
+	// Homographs keep their default reading above and the other ones as candidates, with hints to choose between them
+	PartOfSpeech string         `json:"part_of_speech,omitempty"`
+	Neighbours   []string       `json:"neighbours,omitempty"`
+	Candidates   []SyllableInfo `json:"candidates,omitempty"`
 }
 
 // Syllabify syllabifies a word, first checking the user and liturgical databases, then fetching from an external website if not found.
 func (s *SiteSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	s.mu.RLock()
+
 	// Check if the word is already syllabified in the user database of new words
 	if info, ok := s.userSyllabs[word]; ok {
+		s.mu.RUnlock()
 		return info.Slashed, info.TonicIndex, nil
 	}
 
 	// Check if the word is already syllabified in the embedded json database of liturgical words
 	if info, ok := s.liturgicalSyllabs[word]; ok {
+		s.mu.RUnlock()
 		return info.Slashed, info.TonicIndex, nil
 	}
 
+	s.mu.RUnlock()
+
 	// Fetch the word from a external website, if not found in the databases
-	info, err := fetchSyllabs(ctx, word)
+	info, err := FetchSyllabs(ctx, word)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if err != nil {
-		// Put the word into a list of non-syllabified words
-		s.notSyllabifiedWords = s.notSyllabifiedWords + "\n" + word
+		// Put the word into the queue of non-syllabified words
+		pending := s.notSyllabified[word]
+		pending.Word = word
+		pending.Occurrences++
+		pending.LastSeen = time.Now().UTC()
+		s.notSyllabified[word] = pending
+		s.dirty++
 		return "", 0, fmt.Errorf("syllabifying new word: %w", err)
 	}
 
 	// Add the word to the user database of new words
+	info.Source = words.SourceRemote
+	info.UpdatedAt = time.Now().UTC()
 	s.userSyllabs[word] = info
+	s.dirty++
 
 	return info.Slashed, info.TonicIndex, nil
 }
 
+// Candidates returns all known readings of a word, the default one first. Words that are not homographs have a single candidate.
+func (s *SiteSyllabifier) Candidates(ctx context.Context, word string) ([]words.Candidate, error) {
+	s.mu.RLock()
+	info, ok := s.userSyllabs[word]
+	if !ok {
+		info, ok = s.liturgicalSyllabs[word]
+	}
+	s.mu.RUnlock()
+
+	if !ok {
+		slashed, tonicIndex, err := s.Syllabify(ctx, word)
+		if err != nil {
+			return nil, err
+		}
+
+		return []words.Candidate{{Slashed: slashed, TonicIndex: tonicIndex}}, nil
+	}
+
+	candidates := []words.Candidate{info.candidate()}
+	for _, c := range info.Candidates {
+		candidates = append(candidates, c.candidate())
+	}
+
+	return candidates, nil
+}
+
+// candidate converts the stored info into a words.Candidate.
+func (info SyllableInfo) candidate() words.Candidate {
+	return words.Candidate{Slashed: info.Slashed, TonicIndex: info.TonicIndex, PartOfSpeech: info.PartOfSpeech, Neighbours: info.Neighbours}
+}
+
 // LoadSyllables loads the syllables from the liturgical and user files.
 func (s *SiteSyllabifier) LoadSyllables() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	dataL, err := os.ReadFile(s.liturgicalFilePath)
 	if err != nil {
 		return err
@@ -81,36 +152,75 @@ func (s *SiteSyllabifier) LoadSyllables() error {
 		return fmt.Errorf("unmarshaling file %v: %w", s.userFilePath, err)
 	}
 
+	if err := s.validate(); err != nil {
+		return err
+	}
+
 	dataNS, err := os.ReadFile(s.notSyllabifiedFilePath)
 	if err != nil {
 		return err
 	}
 
-	s.notSyllabifiedWords = string(dataNS)
+	s.notSyllabified = parseNotSyllabified(string(dataNS))
+	s.dirty = 0
 
 	return nil
 }
 
 // SaveSyllables saves the user syllables and the not syllabified words to their respective files.
+// The files are written outside the lock, so requests keep being served while saving.
 func (s *SiteSyllabifier) SaveSyllables() error {
-	data, err := json.MarshalIndent(s.userSyllabs, "", "  ")
+	s.mu.Lock()
+	saving := s.dirty
+	data, err := json.MarshalIndent(withQuarantined(s.userSyllabs, s.quarantinedUser), "", "  ")
+	notSyllabified := formatNotSyllabified(s.notSyllabified)
+
+	var dataL []byte
+	if s.liturgicalChanged && err == nil {
+		dataL, err = json.MarshalIndent(withQuarantined(s.liturgicalSyllabs, s.quarantinedLiturgical), "", "  ")
+		s.liturgicalChanged = false
+	}
+	s.mu.Unlock()
+
 	if err != nil {
 		return fmt.Errorf("marshalling syllables to JSON: %w", err)
 	}
 
+	if dataL != nil {
+		if err := os.WriteFile(s.liturgicalFilePath, dataL, 0644); err != nil {
+			s.mu.Lock()
+			s.liturgicalChanged = true
+			s.mu.Unlock()
+			return fmt.Errorf("writing syllables to file %s: %w", s.liturgicalFilePath, err)
+		}
+	}
+
 	if err := os.WriteFile(s.userFilePath, data, 0644); err != nil {
 		return fmt.Errorf("writing syllables to file %s: %w", s.userFilePath, err)
 	}
 
-	if err := os.WriteFile(s.notSyllabifiedFilePath, []byte(s.notSyllabifiedWords), 0644); err != nil {
+	if err := os.WriteFile(s.notSyllabifiedFilePath, []byte(notSyllabified), 0644); err != nil {
 		return fmt.Errorf("writing syllables to file %s: %w", s.notSyllabifiedFilePath, err)
 	}
 
+	// Changes made while the files were being written stay dirty until the next save
+	s.mu.Lock()
+	s.dirty -= saving
+	s.mu.Unlock()
+
 	return nil
 }
 
-// fetchSyllabs fetches the syllables of a word from the external website.
-func fetchSyllabs(ctx context.Context, word string) (SyllableInfo, error) {
+// Dirty returns the number of changes made since the last save.
+func (s *SiteSyllabifier) Dirty() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.dirty
+}
+
+// FetchSyllabs fetches the syllables of a word from the external website.
+func FetchSyllabs(ctx context.Context, word string) (SyllableInfo, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://www.separaremsilabas.com/index.php?lang=index.php&p="+word+"&button=Separa%C3%A7%C3%A3o+das+s%C3%ADlabas", nil)
 	if err != nil {
 		return SyllableInfo{}, fmt.Errorf("fetching syllables of %v from separaremsilabas.com: %w", word, err)
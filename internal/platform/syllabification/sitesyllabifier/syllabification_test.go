@@ -3,41 +3,74 @@ package sitesyllabifier_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
 	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/sitesyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
 )
 
 var ctx context.Context = context.Background()
 
+// copyFixture copies a checked-in fixture into a temporary directory, so the test can write to it without changing the fixture.
+func copyFixture(is *is.I, dir, name string) string {
+	data, err := os.ReadFile(name)
+	is.NoErr(err)
+
+	path := filepath.Join(dir, name)
+	is.NoErr(os.WriteFile(path, data, 0644))
+
+	return path
+}
+
 func TestSyllabify(t *testing.T) {
 	is := is.New(t)
-	syllabifier := sitesyllabifier.NewSyllabifier("test_liturgical_syllables.json", "test_user_syllables.json", "test_not_syllabified.txt")
-	is.NoErr(os.WriteFile("test_user_syllables.json", []byte("{}"), 0644)) //write an empty json file to the user syllables path
+	dir := t.TempDir()
+	liturgicalPath := copyFixture(is, dir, "test_liturgical_syllables.json")
+	userPath := copyFixture(is, dir, "test_user_syllables.json")
+	notSyllabifiedPath := copyFixture(is, dir, "test_not_syllabified.txt")
 
 	t.Run("fetch syllables from words that are already at liturgical syllabs db file", func(t *testing.T) {
 		is := is.New(t)
+		syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
 
 		jsonWord := map[string]sitesyllabifier.SyllableInfo{
 			"litúrgicas": {
-				Slashed:    "fetched/in/liturgical/db",
+				Slashed:    "li/tú/rgic/as",
 				TonicIndex: 2,
 			}}
 		data, err := json.MarshalIndent(jsonWord, "", "  ")
 		is.NoErr(err)
-		is.NoErr(os.WriteFile("test_liturgical_syllables.json", data, 0644))
+		is.NoErr(os.WriteFile(liturgicalPath, data, 0644))
 		is.NoErr(syllabifier.LoadSyllables())
 
 		slashed, tonicIndex, err := syllabifier.Syllabify(ctx, "litúrgicas")
 		is.NoErr(err)
-		is.Equal(slashed, "fetched/in/liturgical/db") // proposital wrong answer, to ensure that the syllables were fetched from the liturgical db
+		is.Equal(slashed, "li/tú/rgic/as") // proposital wrong split, which still passes validation, to ensure that the syllables were fetched from the liturgical db
+		is.Equal(tonicIndex, 2)
+	})
+
+	t.Run("fetch syllables from words that are already at user syllabs db file", func(t *testing.T) {
+		is := is.New(t)
+		syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+		is.NoErr(syllabifier.LoadSyllables())
+
+		slashed, tonicIndex, err := syllabifier.Syllabify(ctx, "externo")
+		is.NoErr(err)
+		is.Equal(slashed, "ex/ter/no") // from the user db fixture, without reaching the external site
 		is.Equal(tonicIndex, 2)
 	})
 
 	t.Run("fetch syllables from new words at external site", func(t *testing.T) {
 		is := is.New(t)
+		syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+		is.NoErr(os.WriteFile(userPath, []byte("{}"), 0644)) //write an empty json file to the user syllables path
+		is.NoErr(syllabifier.LoadSyllables())
 
 		newWord := "externo"
 		slashed, tonicIndex, err := syllabifier.Syllabify(ctx, newWord)
@@ -47,16 +80,58 @@ func TestSyllabify(t *testing.T) {
 
 		syllabifier.SaveSyllables()
 
-		jsonWord := map[string]sitesyllabifier.SyllableInfo{
-			newWord: {
-				Slashed:    slashed,
-				TonicIndex: tonicIndex,
-			},
-		}
-		data, err := json.MarshalIndent(jsonWord, "", "  ")
+		fileContent, err := os.ReadFile(userPath)
+		is.NoErr(err)
+		var saved map[string]sitesyllabifier.SyllableInfo
+		is.NoErr(json.Unmarshal(fileContent, &saved))
+		is.Equal(saved[newWord].Slashed, slashed) // check if the user db file was created with the new word
+		is.Equal(saved[newWord].TonicIndex, tonicIndex)
+		is.Equal(saved[newWord].Source, "remote") // and its provenance
+	})
+}
+
+func TestLoadSyllablesValidation(t *testing.T) {
+	dir := t.TempDir()
+	liturgicalPath := filepath.Join(dir, "liturgical.json")
+	userPath := filepath.Join(dir, "user.json")
+	notSyllabifiedPath := filepath.Join(dir, "not_syllabified.txt")
+
+	writeFiles := func(is *is.I) {
+		is.NoErr(os.WriteFile(liturgicalPath, []byte(`{"glória": {"slashed": "gló/ri/a", "tonic_index": 1}, "santo": {"slashed": "san/to", "tonic_index": 0}}`), 0644))
+		is.NoErr(os.WriteFile(userPath, []byte(`{"cordeiro": {"slashed": "cor/dei/ros", "tonic_index": 2}, "paz": {"slashed": "paz", "tonic_index": 2}}`), 0644))
+		is.NoErr(os.WriteFile(notSyllabifiedPath, nil, 0644))
+	}
+
+	t.Run("strict mode refuses to load and reports every bad entry", func(t *testing.T) {
+		is := is.New(t)
+		writeFiles(is)
+
+		err := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath).LoadSyllables()
+
+		var validationErr *words.ValidationError
+		is.True(errors.As(err, &validationErr))
+		is.Equal(len(validationErr.Entries), 3)
+		is.True(errors.Is(validationErr.Entries[0].Reason, gabcErrors.ErrTonicIndex))      // santo, from the liturgical file
+		is.True(errors.Is(validationErr.Entries[1].Reason, gabcErrors.ErrSlashedMismatch)) // cordeiro, from the user file
+		is.True(errors.Is(validationErr.Entries[2].Reason, gabcErrors.ErrTonicIndex))      // paz
+	})
+
+	t.Run("lenient mode quarantines bad entries and keeps them in the files", func(t *testing.T) {
+		is := is.New(t)
+		writeFiles(is)
+
+		syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+		syllabifier.SetValidationMode(words.Lenient)
+		is.NoErr(syllabifier.LoadSyllables())
+		is.Equal(len(syllabifier.Quarantined()), 3)
+
+		slashed, _, err := syllabifier.Syllabify(ctx, "glória")
 		is.NoErr(err)
-		fileContent, err := os.ReadFile("test_user_syllables.json")
+		is.Equal(slashed, "gló/ri/a")
+
+		is.NoErr(syllabifier.SaveSyllables())
+		data, err := os.ReadFile(userPath)
 		is.NoErr(err)
-		is.Equal(fileContent, data) // check if the user db file was created with the new word
+		is.True(strings.Contains(string(data), "cor/dei/ros"))
 	})
 }
@@ -0,0 +1,92 @@
+// Package sitesyllabifier is an adapter that fetches syllables from an external website.
+package sitesyllabifier
+
+import (
+	"log"
+	"maps"
+	"sort"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// SetValidationMode chooses what LoadSyllables does with invalid entries. The default is Strict.
+func (s *SiteSyllabifier) SetValidationMode(mode words.ValidationMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.validation = mode
+}
+
+// Quarantined returns the invalid entries left out by the last load in Lenient mode.
+func (s *SiteSyllabifier) Quarantined() []words.InvalidEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.quarantined
+}
+
+// validate checks every loaded entry. In Strict mode all invalid entries are returned together in a words.ValidationError,
+// in Lenient mode they are moved out of the lookup maps and logged.
+func (s *SiteSyllabifier) validate() error {
+	invalidL := invalidEntries(s.liturgicalFilePath, s.liturgicalSyllabs)
+	invalidU := invalidEntries(s.userFilePath, s.userSyllabs)
+	invalid := append(invalidL, invalidU...)
+
+	s.quarantined = nil
+	s.quarantinedLiturgical = make(map[string]SyllableInfo)
+	s.quarantinedUser = make(map[string]SyllableInfo)
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	if s.validation == words.Strict {
+		return &words.ValidationError{Entries: invalid}
+	}
+
+	for _, v := range invalidL {
+		s.quarantinedLiturgical[v.Word] = s.liturgicalSyllabs[v.Word]
+		delete(s.liturgicalSyllabs, v.Word)
+	}
+
+	for _, v := range invalidU {
+		s.quarantinedUser[v.Word] = s.userSyllabs[v.Word]
+		delete(s.userSyllabs, v.Word)
+	}
+
+	s.quarantined = invalid
+	log.Printf("quarantined %v", &words.ValidationError{Entries: invalid})
+
+	return nil
+}
+
+// invalidEntries checks the entries of a database, sorted by word so reports are stable.
+func invalidEntries(database string, syllabs map[string]SyllableInfo) []words.InvalidEntry {
+	var invalid []words.InvalidEntry
+
+	for word, info := range syllabs {
+		for _, c := range append([]SyllableInfo{info}, info.Candidates...) { // homograph candidates must spell the same word
+			if err := words.ValidateSyllables(word, c.Slashed, c.TonicIndex); err != nil {
+				invalid = append(invalid, words.InvalidEntry{Database: database, Word: word, Slashed: c.Slashed, TonicIndex: c.TonicIndex, Reason: err})
+				break
+			}
+		}
+	}
+
+	sort.Slice(invalid, func(i, j int) bool { return invalid[i].Word < invalid[j].Word })
+
+	return invalid
+}
+
+// withQuarantined puts the quarantined entries back, so saving a database does not lose them.
+// Valid entries stored for the same words since the load take precedence.
+func withQuarantined(syllabs, quarantined map[string]SyllableInfo) map[string]SyllableInfo {
+	if len(quarantined) == 0 {
+		return syllabs
+	}
+
+	all := maps.Clone(quarantined)
+	maps.Copy(all, syllabs)
+
+	return all
+}
@@ -0,0 +1,139 @@
+// Package teamdictionary is an adapter that keeps the private syllable dictionary of each team in its own JSON file.
+package teamdictionary
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+type Store struct {
+	mu    sync.RWMutex
+	dir   string                      // directory holding a "<team>.json" file for each team
+	teams map[string]map[string]entry // entries of each team, keyed by word
+}
+
+// entry is an entry of a team dictionary, stored in the same format used by the sitesyllabifier package.
+type entry struct {
+	Slashed    string    `json:"slashed"`
+	TonicIndex int       `json:"tonic_index"`
+	UpdatedAt  time.Time `json:"updated_at,omitzero"`
+}
+
+// NewStore creates a new Store for the team files of a directory. The files are read by LoadDictionaries.
+func NewStore(dir string) *Store {
+	return &Store{
+		dir:   dir,
+		teams: make(map[string]map[string]entry),
+	}
+}
+
+// LoadDictionaries reads the dictionary of every team, creating the directory if it does not exist.
+func (s *Store) LoadDictionaries() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("creating team dictionaries directory %v: %w", s.dir, err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return fmt.Errorf("listing team dictionaries: %w", err)
+	}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var entries map[string]entry
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return fmt.Errorf("unmarshaling file %v: %w", path, err)
+		}
+
+		s.teams[strings.TrimSuffix(filepath.Base(path), ".json")] = entries
+	}
+
+	return nil
+}
+
+// TeamEntry looks a word up in the dictionary of a team.
+func (s *Store) TeamEntry(team, word string) (words.Entry, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.teams[team][word]
+	if !ok {
+		return words.Entry{}, false, nil
+	}
+
+	return e.entry(word), true, nil
+}
+
+// TeamEntries lists the dictionary of a team, sorted by word.
+func (s *Store) TeamEntries(team string) ([]words.Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]words.Entry, 0, len(s.teams[team]))
+	for word, e := range s.teams[team] {
+		entries = append(entries, e.entry(word))
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Word < entries[j].Word })
+
+	return entries, nil
+}
+
+// ImportTeamEntries adds the entries to the dictionary of a team, or replaces the whole dictionary, saving its file right away.
+// The dictionary is left as it was if the file cannot be written.
+func (s *Store) ImportTeamEntries(team string, entries []words.Entry, replace bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dictionary := make(map[string]entry)
+	if !replace {
+		for word, e := range s.teams[team] {
+			dictionary[word] = e
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, e := range entries {
+		dictionary[e.Word] = entry{Slashed: e.Slashed, TonicIndex: e.TonicIndex, UpdatedAt: now}
+	}
+
+	data, err := json.MarshalIndent(dictionary, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling dictionary of team %v: %w", team, err)
+	}
+
+	path := filepath.Join(s.dir, team+".json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing dictionary of team %v to file %v: %w", team, path, err)
+	}
+
+	s.teams[team] = dictionary
+
+	return nil
+}
+
+// entry converts the stored entry into a words.Entry.
+func (e entry) entry(word string) words.Entry {
+	return words.Entry{
+		Word:       word,
+		Slashed:    e.Slashed,
+		TonicIndex: e.TonicIndex,
+		Source:     words.SourceTeam,
+		UpdatedAt:  e.UpdatedAt,
+	}
+}
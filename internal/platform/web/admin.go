@@ -0,0 +1,301 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+type ReviewService interface {
+	PendingWords(ctx context.Context) ([]words.PendingWord, error)
+	ResolvePendingWord(ctx context.Context, word, slashed string, tonicIndex int) error
+	DismissPendingWord(ctx context.Context, word string) error
+}
+
+type CurationService interface {
+	UnreviewedEntries(ctx context.Context) ([]words.Entry, error)
+	ApproveEntry(ctx context.Context, word, slashed string, tonicIndex int) error
+	SyllableConflicts(ctx context.Context) ([]words.Conflict, error)
+	ResolveConflict(ctx context.Context, word, keep string) error
+}
+
+type CoverageService interface {
+	CorpusCoverage(ctx context.Context, texts []string) (service.Coverage, error)
+}
+
+type AdminService interface {
+	ReviewService
+	CurationService
+	CoverageService
+}
+
+type AdminHandler struct {
+	reviewAPI   ReviewService
+	curationAPI CurationService
+	coverageAPI CoverageService
+}
+
+func NewAdminHandler(service AdminService) AdminHandler {
+	return AdminHandler{
+		reviewAPI:   service,
+		curationAPI: service,
+		coverageAPI: service,
+	}
+}
+
+type PendingWordJSON struct {
+	Word        string    `json:"word"`
+	Occurrences int       `json:"occurrences"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+type ReviewedWordJSON struct {
+	Word       string `json:"word"`
+	Slashed    string `json:"slashed"`     // syllables separated by slashes, like "glo/ri/a"
+	TonicIndex int    `json:"tonic_index"` // index of the tonic syllable starting from 1
+}
+
+// PendingWords lists the words waiting for review (GET), resolves one of them with its reviewed syllables (POST) or dismisses it (DELETE ?word=).
+func (h *AdminHandler) PendingWords(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		pending, err := h.reviewAPI.PendingWords(r.Context())
+		if err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		list := make([]PendingWordJSON, 0, len(pending))
+		for _, p := range pending {
+			list = append(list, PendingWordJSON{Word: p.Word, Occurrences: p.Occurrences, LastSeen: p.LastSeen})
+		}
+
+		responseJSON(w, http.StatusOK, list)
+
+	case http.MethodPost:
+		var reviewed ReviewedWordJSON
+		if err := json.NewDecoder(r.Body).Decode(&reviewed); err != nil {
+			http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if reviewed.Word == "" || reviewed.Slashed == "" {
+			http.Error(w, "word and slashed fields are required", http.StatusBadRequest)
+			return
+		}
+
+		if err := h.reviewAPI.ResolvePendingWord(r.Context(), reviewed.Word, reviewed.Slashed, reviewed.TonicIndex); err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodDelete:
+		word := r.URL.Query().Get("word")
+		if word == "" {
+			http.Error(w, "word query parameter is required", http.StatusBadRequest)
+			return
+		}
+
+		if err := h.reviewAPI.DismissPendingWord(r.Context(), word); err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+type EntryJSON struct {
+	Word       string    `json:"word"`
+	Slashed    string    `json:"slashed"`
+	TonicIndex int       `json:"tonic_index"`
+	Source     string    `json:"source"` // provenance of the entry: "liturgical", "user", "remote" or empty when unknown
+	UpdatedAt  time.Time `json:"updated_at,omitzero"`
+	ReviewedAt time.Time `json:"reviewed_at,omitzero"`
+}
+
+type ConflictJSON struct {
+	Word       string    `json:"word"`
+	User       EntryJSON `json:"user"`
+	Liturgical EntryJSON `json:"liturgical"`
+}
+
+type ResolveConflictJSON struct {
+	Word string `json:"word"`
+	Keep string `json:"keep"` // "user" or "liturgical"
+}
+
+// Curation lists the user entries not reviewed yet (GET) or approves one of them into the liturgical database (POST).
+// An approval without the slashed field keeps the entry as it is.
+func (h *AdminHandler) Curation(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		entries, err := h.curationAPI.UnreviewedEntries(r.Context())
+		if err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		list := make([]EntryJSON, 0, len(entries))
+		for _, e := range entries {
+			list = append(list, entryJSON(e))
+		}
+
+		responseJSON(w, http.StatusOK, list)
+
+	case http.MethodPost:
+		var approved ReviewedWordJSON
+		if err := json.NewDecoder(r.Body).Decode(&approved); err != nil {
+			http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if approved.Word == "" {
+			http.Error(w, "word field is required", http.StatusBadRequest)
+			return
+		}
+
+		if err := h.curationAPI.ApproveEntry(r.Context(), approved.Word, approved.Slashed, approved.TonicIndex); err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// Conflicts lists the words syllabified differently in the user and liturgical databases (GET) or resolves one of them (POST).
+func (h *AdminHandler) Conflicts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		conflicts, err := h.curationAPI.SyllableConflicts(r.Context())
+		if err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		list := make([]ConflictJSON, 0, len(conflicts))
+		for _, c := range conflicts {
+			list = append(list, ConflictJSON{Word: c.Word, User: entryJSON(c.User), Liturgical: entryJSON(c.Liturgical)})
+		}
+
+		responseJSON(w, http.StatusOK, list)
+
+	case http.MethodPost:
+		var resolve ResolveConflictJSON
+		if err := json.NewDecoder(r.Body).Decode(&resolve); err != nil {
+			http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if resolve.Word == "" || resolve.Keep == "" {
+			http.Error(w, "word and keep fields are required", http.StatusBadRequest)
+			return
+		}
+
+		if err := h.curationAPI.ResolveConflict(r.Context(), resolve.Word, resolve.Keep); err != nil {
+			handleAdminError(err, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+type CorpusJSON struct {
+	Texts []string `json:"texts"` // each text of the corpus, lined like the text of a preface
+}
+
+type CoverageJSON struct {
+	Texts      int                `json:"texts"`
+	Total      int                `json:"total"`      // occurrences of all the words
+	Liturgical int                `json:"liturgical"` // occurrences of the words in the liturgical database
+	User       int                `json:"user"`       // occurrences of the words in the user database
+	Missing    int                `json:"missing"`    // occurrences of the words in no database
+	Words      []WordCoverageJSON `json:"words"`      // the most frequent first
+}
+
+type WordCoverageJSON struct {
+	Word     string `json:"word"`
+	Count    int    `json:"count"`
+	Database string `json:"database,omitempty"` // "liturgical" or "user", empty when no database has the word
+}
+
+// Coverage reports which words of a corpus of texts are in the syllable databases and how often they appear (POST).
+// The ?missing=true query lists only the words no database has.
+func (h *AdminHandler) Coverage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var corpus CorpusJSON
+	if err := json.NewDecoder(r.Body).Decode(&corpus); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(corpus.Texts) == 0 {
+		http.Error(w, "texts field is required", http.StatusBadRequest)
+		return
+	}
+
+	coverage, err := h.coverageAPI.CorpusCoverage(r.Context(), corpus.Texts)
+	if err != nil {
+		handleAdminError(err, w)
+		return
+	}
+
+	missingOnly := r.URL.Query().Get("missing") == "true"
+
+	c := CoverageJSON{Texts: coverage.Texts, Total: coverage.Total, Liturgical: coverage.Liturgical, User: coverage.User, Missing: coverage.Missing, Words: []WordCoverageJSON{}}
+	for _, v := range coverage.Words {
+		if missingOnly && v.Database != "" {
+			continue
+		}
+
+		c.Words = append(c.Words, WordCoverageJSON{Word: v.Word, Count: v.Count, Database: v.Database})
+	}
+
+	responseJSON(w, http.StatusOK, c)
+}
+
+// entryJSON converts a words.Entry into its JSON response.
+func entryJSON(e words.Entry) EntryJSON {
+	return EntryJSON{
+		Word:       e.Word,
+		Slashed:    e.Slashed,
+		TonicIndex: e.TonicIndex,
+		Source:     e.Source,
+		UpdatedAt:  e.UpdatedAt,
+		ReviewedAt: e.ReviewedAt,
+	}
+}
+
+// handleAdminError sends the admin specific statuses before falling back to handleError.
+func handleAdminError(err error, w http.ResponseWriter) {
+	if errors.Is(err, gabcErrors.ErrNotPending) || errors.Is(err, gabcErrors.ErrNotUserEntry) || errors.Is(err, gabcErrors.ErrNotLiturgicalEntry) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	handleError(err, w)
+}
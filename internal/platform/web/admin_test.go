@@ -0,0 +1,224 @@
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/sitesyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+func TestAdminPendingWords(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	liturgicalPath := filepath.Join(dir, "liturgical.json")
+	userPath := filepath.Join(dir, "user.json")
+	notSyllabifiedPath := filepath.Join(dir, "not_syllabified.txt")
+	is.NoErr(os.WriteFile(liturgicalPath, []byte("{}"), 0644))
+	is.NoErr(os.WriteFile(userPath, []byte("{}"), 0644))
+	is.NoErr(os.WriteFile(notSyllabifiedPath, []byte("quarentena\t3\t2025-03-05T10:00:00Z\nxpto\n"), 0644))
+
+	syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+	is.NoErr(syllabifier.LoadSyllables())
+
+	adminHandler := web.NewAdminHandler(service.NewGabcGenAPI(syllabifier))
+	mux := http.NewServeMux()
+	mux.Handle("/admin/pending", web.RequireToken("secret")(http.HandlerFunc(adminHandler.PendingWords)))
+	server := web.NewServer(web.ServerConfig{Port: 8080, DisableRateLimit: true}, mux)
+
+	serve := func(method, target, body, token string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(method, target, strings.NewReader(body))
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		response := httptest.NewRecorder()
+		server.Handler.ServeHTTP(response, request)
+		return response
+	}
+
+	t.Run("requires the admin token", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serve(http.MethodGet, "/admin/pending", "", "").Code, http.StatusUnauthorized)
+		is.Equal(serve(http.MethodGet, "/admin/pending", "", "wrong").Code, http.StatusUnauthorized)
+	})
+
+	t.Run("lists pending words with occurrences and last seen time", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serve(http.MethodGet, "/admin/pending", "", "secret")
+		is.Equal(response.Code, http.StatusOK)
+
+		var list []web.PendingWordJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&list))
+		is.Equal(len(list), 2)
+		is.Equal(list[0].Word, "quarentena") // the most frequent first
+		is.Equal(list[0].Occurrences, 3)
+		is.Equal(list[0].LastSeen.Year(), 2025)
+		is.Equal(list[1].Word, "xpto")
+		is.Equal(list[1].Occurrences, 1)
+	})
+
+	t.Run("rejects syllables whose letters do not match the word", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serve(http.MethodPost, "/admin/pending", `{"word": "quarentena", "slashed": "qua/ren/te/ma", "tonic_index": 3}`, "secret").Code, http.StatusBadRequest)
+		is.Equal(serve(http.MethodPost, "/admin/pending", `{"word": "quarentena", "slashed": "qua/ren/te/na", "tonic_index": 5}`, "secret").Code, http.StatusBadRequest)
+		is.Equal(serve(http.MethodPost, "/admin/pending", `{"word": "inexistente", "slashed": "i/ne/xis/ten/te", "tonic_index": 4}`, "secret").Code, http.StatusNotFound)
+	})
+
+	t.Run("accepted words go to the user database and leave the queue", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serve(http.MethodPost, "/admin/pending", `{"word": "Quarentena", "slashed": "qua/ren/te/na", "tonic_index": 3}`, "secret").Code, http.StatusNoContent)
+		is.Equal(serve(http.MethodDelete, "/admin/pending?word=xpto", "", "secret").Code, http.StatusNoContent)
+
+		slashed, tonicIndex, err := syllabifier.Syllabify(context.Background(), "quarentena")
+		is.NoErr(err)
+		is.Equal(slashed, "qua/ren/te/na")
+		is.Equal(tonicIndex, 3)
+
+		pending, err := syllabifier.PendingWords()
+		is.NoErr(err)
+		is.Equal(len(pending), 0)
+	})
+}
+
+func TestAdminCuration(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	liturgicalPath := filepath.Join(dir, "liturgical.json")
+	userPath := filepath.Join(dir, "user.json")
+	notSyllabifiedPath := filepath.Join(dir, "not_syllabified.txt")
+	is.NoErr(os.WriteFile(liturgicalPath, []byte(`{"glória": {"slashed": "gló/ri/a", "tonic_index": 1}}`), 0644))
+	is.NoErr(os.WriteFile(userPath, []byte(`{"glória": {"slashed": "gló/ria", "tonic_index": 1}, "vigília": {"slashed": "vi/gí/li/a", "tonic_index": 2, "source": "remote", "updated_at": "2025-03-05T10:00:00Z"}}`), 0644))
+	is.NoErr(os.WriteFile(notSyllabifiedPath, nil, 0644))
+
+	syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+	is.NoErr(syllabifier.LoadSyllables())
+
+	adminHandler := web.NewAdminHandler(service.NewGabcGenAPI(syllabifier))
+	mux := http.NewServeMux()
+	mux.Handle("/admin/curation", web.RequireToken("secret")(http.HandlerFunc(adminHandler.Curation)))
+	mux.Handle("/admin/conflicts", web.RequireToken("secret")(http.HandlerFunc(adminHandler.Conflicts)))
+	server := web.NewServer(web.ServerConfig{Port: 8080, DisableRateLimit: true}, mux)
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(method, target, strings.NewReader(body))
+		request.Header.Set("Authorization", "Bearer secret")
+		response := httptest.NewRecorder()
+		server.Handler.ServeHTTP(response, request)
+		return response
+	}
+
+	t.Run("lists unreviewed user entries with their provenance", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serve(http.MethodGet, "/admin/curation", "")
+		is.Equal(response.Code, http.StatusOK)
+
+		var list []web.EntryJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&list))
+		is.Equal(len(list), 2)
+		is.Equal(list[1].Word, "vigília")
+		is.Equal(list[1].Source, "remote")
+		is.Equal(list[1].UpdatedAt.Year(), 2025)
+	})
+
+	t.Run("approves a user entry into the liturgical database", func(t *testing.T) {
+		is := is.New(t)
+
+		is.Equal(serve(http.MethodPost, "/admin/curation", `{"word": "vigília"}`).Code, http.StatusNoContent)
+		is.Equal(serve(http.MethodPost, "/admin/curation", `{"word": "vigília"}`).Code, http.StatusNotFound) // already approved
+		is.NoErr(syllabifier.SaveSyllables())
+
+		data, err := os.ReadFile(liturgicalPath)
+		is.NoErr(err)
+		is.True(strings.Contains(string(data), `"vi/gí/li/a"`))
+		is.True(strings.Contains(string(data), `"reviewed_at"`))
+	})
+
+	t.Run("detects and resolves conflicts between the databases", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serve(http.MethodGet, "/admin/conflicts", "")
+		is.Equal(response.Code, http.StatusOK)
+
+		var list []web.ConflictJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&list))
+		is.Equal(len(list), 1)
+		is.Equal(list[0].User.Slashed, "gló/ria")
+		is.Equal(list[0].Liturgical.Slashed, "gló/ri/a")
+
+		is.Equal(serve(http.MethodPost, "/admin/conflicts", `{"word": "glória", "keep": "both"}`).Code, http.StatusBadRequest)
+		is.Equal(serve(http.MethodPost, "/admin/conflicts", `{"word": "glória", "keep": "liturgical"}`).Code, http.StatusNoContent)
+
+		slashed, _, err := syllabifier.Syllabify(context.Background(), "glória")
+		is.NoErr(err)
+		is.Equal(slashed, "gló/ri/a")
+	})
+}
+
+func TestAdminCoverage(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	liturgicalPath := filepath.Join(dir, "liturgical.json")
+	userPath := filepath.Join(dir, "user.json")
+	notSyllabifiedPath := filepath.Join(dir, "not_syllabified.txt")
+	is.NoErr(os.WriteFile(liturgicalPath, []byte(`{"glória": {"slashed": "gló/ri/a", "tonic_index": 1}, "ao": {"slashed": "ao", "tonic_index": 1}}`), 0644))
+	is.NoErr(os.WriteFile(userPath, []byte(`{"louvar": {"slashed": "lou/var", "tonic_index": 2}, "ao": {"slashed": "ao", "tonic_index": 1}}`), 0644))
+	is.NoErr(os.WriteFile(notSyllabifiedPath, nil, 0644))
+
+	syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+	is.NoErr(syllabifier.LoadSyllables())
+
+	adminHandler := web.NewAdminHandler(service.NewGabcGenAPI(syllabifier))
+	mux := http.NewServeMux()
+	mux.Handle("/admin/coverage", web.RequireToken("secret")(http.HandlerFunc(adminHandler.Coverage)))
+	server := web.NewServer(web.ServerConfig{Port: 8080, DisableRateLimit: true}, mux)
+
+	serve := func(target, body string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		request.Header.Set("Authorization", "Bearer secret")
+		response := httptest.NewRecorder()
+		server.Handler.ServeHTTP(response, request)
+		return response
+	}
+
+	corpus := `{"texts": ["Glória ao Pai,\n louvar-vos (de pé) com júbilo.", "Glória, glória ao Cordeiro!", ""]}`
+
+	t.Run("counts the words covered by each database", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serve("/admin/coverage", corpus)
+		is.Equal(response.Code, http.StatusOK)
+
+		var coverage web.CoverageJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&coverage))
+		is.Equal(coverage.Texts, 2)
+		is.Equal(coverage.Total, 10)
+		is.Equal(coverage.Liturgical, 5) // "ao" is also in the user database
+		is.Equal(coverage.User, 1)       // the verb of "louvar-vos"
+		is.Equal(coverage.Missing, 4)    // the rubric "de pé" is not sung
+		is.Equal(coverage.Words[0], web.WordCoverageJSON{Word: "glória", Count: 3, Database: "liturgical"})
+	})
+
+	t.Run("lists only the missing words, the most frequent first", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serve("/admin/coverage?missing=true", corpus)
+		is.Equal(response.Code, http.StatusOK)
+
+		var coverage web.CoverageJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&coverage))
+		is.Equal(coverage.Words, []web.WordCoverageJSON{{Word: "com", Count: 1}, {Word: "cordeiro", Count: 1}, {Word: "júbilo", Count: 1}, {Word: "pai", Count: 1}})
+	})
+}
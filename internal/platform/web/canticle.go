@@ -0,0 +1,51 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type CanticleJSON struct {
+	Canticle string          `json:"canticle"`           // "magnificat", "benedictus" or "nunc-dimittis"
+	Tone     string          `json:"tone"`               // like "8G", "1" or "per"
+	Antiphon string          `json:"antiphon,omitempty"` // GABC of the antiphon sung before and after the canticle
+	Stress   map[string]int  `json:"stress,omitempty"`
+	Atonic   map[string]bool `json:"atonic,omitempty"`
+	Explain  bool            `json:"explain,omitempty"`
+	Team     string          `json:"team,omitempty"`
+}
+
+// Canticle handles requests to generate GABC code for a gospel canticle of the catalogue, sung on a Gregorian psalm tone.
+func (h *GabcHandler) Canticle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var canticleEntry CanticleJSON
+	if err := json.NewDecoder(r.Body).Decode(&canticleEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if canticleEntry.Canticle == "" || canticleEntry.Tone == "" {
+		http.Error(w, "canticle and tone fields are required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: canticleEntry.Stress, Atonic: canticleEntry.Atonic, Explain: canticleEntry.Explain, Team: canticleEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposeCanticle(r.Context(), canticleEntry.Canticle, canticleEntry.Tone, canticleEntry.Antiphon, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
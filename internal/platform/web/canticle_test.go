@@ -0,0 +1,40 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestCanticle(t *testing.T) {
+	t.Run("composes the canticle between the antiphons", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Canticle, http.MethodPost, "/psalm/canticle", `{"canticle": "nunc-dimittis", "tone": "1", "antiphon": "(c4) A(g)men.(h) (::)"}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.True(strings.HasPrefix(gabc.Gabc, "(c4) A(g)men.(h) (::)\n\n(c4) Dei(f)xai,(gh) a(h)go(h)ra,(h)"))
+		is.True(strings.HasSuffix(gabc.Gabc, "pe(h)los(h) sé(h)cu(h)los(h) dos(h) sé(h)cu(h)los.(g) A(f)mém.(ed) (::)\n\n(c4) A(g)men.(h) (::)"))
+	})
+
+	t.Run("requires the canticle and the tone", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Canticle, http.MethodPost, "/psalm/canticle", `{"canticle": "magnificat"}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "canticle and tone fields are required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Canticle, http.MethodPost, "/psalm/canticle", `{"canticle": "te deum", "tone": "8G"}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,52 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type EucharisticJSON struct {
+	Prayer   string          `json:"prayer"`             // "I", "II", "III" or "IV"
+	Part     string          `json:"part"`               // "epiclesis", "institution" or "doxology"
+	Text     string          `json:"text,omitempty"`     // may be left empty for the doxology and the Latin epiclesis
+	Language string          `json:"language,omitempty"` // "pt" or "la", the language of the doxology and of the Amém
+	Stress   map[string]int  `json:"stress,omitempty"`
+	Atonic   map[string]bool `json:"atonic,omitempty"`
+	Explain  bool            `json:"explain,omitempty"`
+	Team     string          `json:"team,omitempty"`
+}
+
+// Eucharistic handles requests to generate GABC code for a part of an Eucharistic Prayer.
+func (h *GabcHandler) Eucharistic(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var prayerEntry EucharisticJSON
+	if err := json.NewDecoder(r.Body).Decode(&prayerEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if prayerEntry.Prayer == "" || prayerEntry.Part == "" {
+		http.Error(w, "prayer and part fields are required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: prayerEntry.Stress, Atonic: prayerEntry.Atonic, Explain: prayerEntry.Explain, Team: prayerEntry.Team, Language: prayerEntry.Language}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposeEucharistic(r.Context(), prayerEntry.Prayer, prayerEntry.Part, prayerEntry.Text, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
@@ -0,0 +1,40 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestEucharistic(t *testing.T) {
+	t.Run("composes the part of the prayer", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Eucharistic, http.MethodPost, "/eucharistic", `{"prayer": "II", "part": "institution", "text": "Na verdade, é digno:\n“Cristo, nosso Senhor,\né justo.”\nPor isso, é digno."}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "Na(h) ver(h)da(h)de,(h) é(h) dig(g)no:(g) (;)\n"+
+			"\"Cris(g)to,(g) nos(g)so(g) Se(g)nhor,(gf) (,)\né(f) jus(e)to.\"(e) (:)\n"+
+			"Por(h) is(h)so,(h) é(g) dig(f)no.(f) (::)")
+	})
+
+	t.Run("requires the prayer and the part", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Eucharistic, http.MethodPost, "/eucharistic", `{"part": "epiclesis", "text": "Na verdade, é digno."}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "prayer and part fields are required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Eucharistic, http.MethodPost, "/eucharistic", `{"prayer": "V", "part": "doxology"}`)
+		is.Equal(response.Code, http.StatusBadRequest) // there is no fifth Eucharistic Prayer
+	})
+}
@@ -0,0 +1,51 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type ExsultetJSON struct {
+	Minister     string          `json:"minister,omitempty"` // "deacon" (default), "presbyter" or "cantor"
+	Introduction string          `json:"introduction"`       // paragraphs sung before the dialogue
+	Text         string          `json:"text"`               // paragraphs sung after the dialogue
+	Stress       map[string]int  `json:"stress,omitempty"`
+	Atonic       map[string]bool `json:"atonic,omitempty"`
+	Explain      bool            `json:"explain,omitempty"`
+	Team         string          `json:"team,omitempty"`
+}
+
+// Exsultet handles requests to generate GABC code for the Easter Proclamation.
+func (h *GabcHandler) Exsultet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var exsultetEntry ExsultetJSON
+	if err := json.NewDecoder(r.Body).Decode(&exsultetEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if exsultetEntry.Introduction == "" || exsultetEntry.Text == "" {
+		http.Error(w, "introduction and text fields are required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: exsultetEntry.Stress, Atonic: exsultetEntry.Atonic, Explain: exsultetEntry.Explain, Team: exsultetEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposeExsultet(r.Context(), exsultetEntry.Minister, exsultetEntry.Introduction, exsultetEntry.Text, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
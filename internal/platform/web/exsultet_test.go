@@ -0,0 +1,55 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestExsultet(t *testing.T) {
+	introduction := `"introduction": "Na verdade, é digno e justo,\nNa verdade,\né digno\nna verdade, é justo.\n\nPor isso, na verdade,\nNa verdade, digno,\nnosso Senhor\nna verdade, é justo."`
+	text := `"text": "Esta é a noite,\nNa verdade, digno,\nnosso Senhor\nCristo, nosso Senhor."`
+
+	t.Run("the deacon sings the invitation, when no minister is given", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Exsultet, http.MethodPost, "/exsultet", `{`+introduction+`, `+text+`}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.True(strings.HasPrefix(gabc.Gabc, "<c><sp>V/</sp></c> Na(e) ver(f)da(h)de,(h) é(h) dig(h)no(h) e(gf) jus(fg)to,(g) (;)\n"))
+		is.True(strings.Contains(gabc.Gabc, "Por(f) is(f)so,(f) na(f) ver(f)da(ef)de,(f) (,)\n")) // the invitation
+		is.True(strings.HasSuffix(gabc.Gabc, "Cris(g)to,(g) nos(fe)so(ef) Se(g)nhor.(fgf) (::)"))
+	})
+
+	t.Run("a cantor leaves out the invitation", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Exsultet, http.MethodPost, "/exsultet", `{"minister": "cantor", `+introduction+`, `+text+`}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.True(!strings.Contains(gabc.Gabc, "Por(f) is(f)so,(f)"))
+	})
+
+	t.Run("requires the introduction and the text", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Exsultet, http.MethodPost, "/exsultet", `{`+text+`}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "introduction and text fields are required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Exsultet, http.MethodPost, "/exsultet", `{"minister": "bishop", `+introduction+`, `+text+`}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+	})
+}
@@ -10,10 +10,24 @@ import (
 	"time"
 
 	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service"
 )
 
 type Service interface {
-	GeneratePreface(ctx context.Context, dialogue, text string) (string, error)
+	ComposePreface(ctx context.Context, dialogue, text string, opts service.Options) (service.Score, error)
+	ComposeOration(ctx context.Context, text string, opts service.Options) (service.Score, error)
+	ComposeReading(ctx context.Context, tone, text string, opts service.Options) (service.Score, error)
+	ComposeAnnouncement(ctx context.Context, tone, text string, opts service.Options) (service.Score, error)
+	ReadingAcclamation(ctx context.Context, tone string) (string, error)
+	ComposePsalm(ctx context.Context, tone, verses string, doxology bool, opts service.Options) (service.Score, error)
+	ComposeCanticle(ctx context.Context, canticle, tone, antiphon string, opts service.Options) (service.Score, error)
+	ComposeResponsorial(ctx context.Context, tone, refrain, stanzas string, opts service.Options) (service.Score, error)
+	ComposeExsultet(ctx context.Context, minister, introduction, text string, opts service.Options) (service.Score, error)
+	ComposeEucharistic(ctx context.Context, prayer, part, linedText string, opts service.Options) (service.Score, error)
+	ComposePassion(ctx context.Context, text string, split bool, opts service.Options) (service.PassionScore, error)
+	ComposeLitany(ctx context.Context, response, text string, saints []string, opts service.Options) (service.Score, error)
+	ComposeNoveritis(ctx context.Context, year int, ascensionOnSunday bool, opts service.Options) (service.Score, error)
+	PreviewSyllables(ctx context.Context, text string, opts service.Options) (service.Preview, error)
 }
 
 type GabcHandler struct {
@@ -29,12 +43,62 @@ func NewGabcHandler(service Service, reqTimeout time.Duration) GabcHandler {
 }
 
 type PrefaceJSON struct {
-	Dialogue string `json:"dialogue"`
-	Text     string `json:"text"`
+	Dialogue string          `json:"dialogue"`
+	Text     string          `json:"text"`
+	Stress   map[string]int  `json:"stress,omitempty"`  // tonic index chosen for homographs of this text, like {"sabia": 1}
+	Atonic   map[string]bool `json:"atonic,omitempty"`  // overrides of the function words of this text, like {"por": false}
+	Explain  bool            `json:"explain,omitempty"` // whether to explain the stresses of each phrase in the response
+	Team     string          `json:"team,omitempty"`    // team whose dictionary is looked up first, unless the API key of the request selects one
 }
 
 type GabcJSON struct {
-	Gabc string `json:"gabc"` // GABC code generated by the service to be responded
+	Gabc       string              `json:"gabc"`                 // GABC code generated by the service to be responded
+	Warnings   []WarningJSON       `json:"warnings,omitempty"`   // choices the user should confirm
+	Explain    []string            `json:"explain,omitempty"`    // stresses of each phrase, with the primary ones in upper case and the secondary ones after a "ˌ"
+	Normalized []NormalizationJSON `json:"normalized,omitempty"` // words of the text that were sung as other words, with their original spelling
+	Sanitized  []ChangeJSON        `json:"sanitized,omitempty"`  // changes made to the incoming text, like typographic quotes made plain
+}
+
+type ChangeJSON struct {
+	Reason string `json:"reason"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Count  int    `json:"count"`
+}
+
+type NormalizationJSON struct {
+	Original string `json:"original"`
+	Sung     string `json:"sung"`
+}
+
+type PreviewJSON struct {
+	Phrases    []PhrasePreviewJSON `json:"phrases"`
+	Warnings   []WarningJSON       `json:"warnings,omitempty"`
+	Normalized []NormalizationJSON `json:"normalized,omitempty"`
+	Sanitized  []ChangeJSON        `json:"sanitized,omitempty"`
+}
+
+type PhrasePreviewJSON struct {
+	Text  string            `json:"text"`
+	Words []WordPreviewJSON `json:"words"`
+}
+
+type WordPreviewJSON struct {
+	Word       string   `json:"word"`
+	Syllables  []string `json:"syllables,omitempty"`
+	TonicIndex int      `json:"tonic_index"`        // starting from 1, or 0 when the word is atonic in the phrase
+	Atonic     bool     `json:"atonic,omitempty"`   // function word without stress of its own
+	Source     string   `json:"source,omitempty"`   // "team", "user", "liturgical", "remote" or "rules"
+	Readings   []string `json:"readings,omitempty"` // known readings of a homograph, the chosen one first
+	Failed     bool     `json:"failed,omitempty"`   // whether the word could not be syllabified
+	Error      string   `json:"error,omitempty"`    // why the word could not be syllabified
+}
+
+type WarningJSON struct {
+	Word     string   `json:"word"`
+	Phrase   string   `json:"phrase"`
+	Message  string   `json:"message"`
+	Readings []string `json:"readings,omitempty"` // known readings with the tonic syllable in upper case, the chosen one first
 }
 
 // Ping responds with "pong" to indicate the server is alive.
@@ -67,13 +131,92 @@ func (h *GabcHandler) Preface(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	prefaceGABC, err := h.serviceAPI.GeneratePreface(r.Context(), prefaceEntry.Dialogue, prefaceEntry.Text)
+	opts := service.Options{Stress: prefaceEntry.Stress, Atonic: prefaceEntry.Atonic, Explain: prefaceEntry.Explain, Team: prefaceEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	prefaceScore, err := h.serviceAPI.ComposePreface(r.Context(), prefaceEntry.Dialogue, prefaceEntry.Text, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(prefaceScore))
+}
+
+// Preview handles requests to check the syllabification of a preface text before composing it.
+func (h *GabcHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var prefaceEntry PrefaceJSON
+	if err := json.NewDecoder(r.Body).Decode(&prefaceEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if prefaceEntry.Text == "" {
+		http.Error(w, "text field is required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: prefaceEntry.Stress, Atonic: prefaceEntry.Atonic, Team: prefaceEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	preview, err := h.serviceAPI.PreviewSyllables(r.Context(), prefaceEntry.Text, opts)
 	if err != nil {
 		handleError(err, w)
 		return
 	}
 
-	responseJSON(w, http.StatusOK, GabcJSON{Gabc: prefaceGABC})
+	responseJSON(w, http.StatusOK, previewJSON(preview))
+}
+
+// previewJSON converts a syllabification preview into its JSON response.
+func previewJSON(preview service.Preview) PreviewJSON {
+	p := PreviewJSON{Phrases: []PhrasePreviewJSON{}}
+
+	for _, ph := range preview.Phrases {
+		phJSON := PhrasePreviewJSON{Text: ph.Text, Words: []WordPreviewJSON{}}
+		for _, v := range ph.Words {
+			wJSON := WordPreviewJSON{Word: v.Word, Syllables: v.Syllables, TonicIndex: v.TonicIndex, Atonic: v.Atonic, Source: v.Source, Readings: v.Readings}
+			if v.Err != nil {
+				wJSON.Failed = true
+				wJSON.Error = v.Err.Error()
+			}
+			phJSON.Words = append(phJSON.Words, wJSON)
+		}
+		p.Phrases = append(p.Phrases, phJSON)
+	}
+
+	g := gabcJSON(service.Score{Warnings: preview.Warnings, Normalized: preview.Normalized, Sanitized: preview.Sanitized})
+	p.Warnings, p.Normalized, p.Sanitized = g.Warnings, g.Normalized, g.Sanitized
+
+	return p
+}
+
+// gabcJSON converts a composed score into its JSON response.
+func gabcJSON(score service.Score) GabcJSON {
+	g := GabcJSON{Gabc: score.GABC, Explain: score.Explain}
+
+	for _, v := range score.Warnings {
+		g.Warnings = append(g.Warnings, WarningJSON{Word: v.Word, Phrase: v.Phrase, Message: v.Message, Readings: v.Readings})
+	}
+
+	for _, v := range score.Normalized {
+		g.Normalized = append(g.Normalized, NormalizationJSON{Original: v.Original, Sung: v.Sung})
+	}
+
+	for _, v := range score.Sanitized {
+		g.Sanitized = append(g.Sanitized, ChangeJSON{Reason: v.Reason, From: v.From, To: v.To, Count: v.Count})
+	}
+
+	return g
 }
 
 // responseJSON sends a JSON response with the given status code and body.
@@ -94,11 +237,15 @@ func handleError(err error, w http.ResponseWriter) {
 	log.Println(err)
 
 	var domainErr gabcErrors.DomainErr
+	var unsupportedErr gabcErrors.UnsupportedErr
 
 	if errors.As(err, &domainErr) {
 		domainErr.Message = err.Error()
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
+	} else if errors.As(err, &unsupportedErr) {
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
 	} else if errors.Is(err, context.DeadlineExceeded) {
 		http.Error(w, "context deadline exceeded", http.StatusGatewayTimeout)
 		return
@@ -0,0 +1,51 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type LitanyJSON struct {
+	Response string          `json:"response,omitempty"` // answer of the first invocations, like "rogai por nós"
+	Text     string          `json:"text"`               // one invocation in each line; a line starting with "R." changes the response
+	Saints   []string        `json:"saints,omitempty"`   // names of the saints added to the litany, like "São José"
+	Stress   map[string]int  `json:"stress,omitempty"`
+	Atonic   map[string]bool `json:"atonic,omitempty"`
+	Explain  bool            `json:"explain,omitempty"`
+	Team     string          `json:"team,omitempty"`
+}
+
+// Litany handles requests to generate GABC code for a litany, with the response of the people after each invocation.
+func (h *GabcHandler) Litany(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var litanyEntry LitanyJSON
+	if err := json.NewDecoder(r.Body).Decode(&litanyEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if litanyEntry.Text == "" {
+		http.Error(w, "text field is required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: litanyEntry.Stress, Atonic: litanyEntry.Atonic, Explain: litanyEntry.Explain, Team: litanyEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposeLitany(r.Context(), litanyEntry.Response, litanyEntry.Text, litanyEntry.Saints, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
@@ -0,0 +1,39 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestLitany(t *testing.T) {
+	t.Run("answers the invocations, invoking the added saints", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Litany, http.MethodPost, "/litany", `{"response": "é digno", "text": "Todos os santos,", "saints": ["Cristo"]}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "Cris(g)to,(g) (;) <c><sp>R/</sp></c> é(g) dig(g)no(f) (::)\n"+
+			"To(h)dos(h) os(h) san(g)tos,(g) (;) <c><sp>R/</sp></c> é(g) dig(g)no(f) (::)")
+	})
+
+	t.Run("requires the text", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Litany, http.MethodPost, "/litany", `{"response": "é digno"}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "text field is required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Litany, http.MethodPost, "/litany", `{"text": "Cristo, nosso Senhor,"}`)
+		is.Equal(response.Code, http.StatusBadRequest) // the first invocations have no response
+	})
+}
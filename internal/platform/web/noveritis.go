@@ -0,0 +1,51 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type NoveritisJSON struct {
+	Year            int             `json:"year"`
+	Language        string          `json:"language,omitempty"`         // "pt" (default) or "la"
+	AscensionSunday bool            `json:"ascension_sunday,omitempty"` // whether the Ascension is transferred to the following Sunday
+	Stress          map[string]int  `json:"stress,omitempty"`
+	Atonic          map[string]bool `json:"atonic,omitempty"`
+	Explain         bool            `json:"explain,omitempty"`
+	Team            string          `json:"team,omitempty"`
+}
+
+// Noveritis handles requests to generate GABC code for the announcement of the date of Easter and of the movable feasts of a year.
+func (h *GabcHandler) Noveritis(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var noveritisEntry NoveritisJSON
+	if err := json.NewDecoder(r.Body).Decode(&noveritisEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if noveritisEntry.Year == 0 {
+		http.Error(w, "year field is required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: noveritisEntry.Stress, Atonic: noveritisEntry.Atonic, Explain: noveritisEntry.Explain, Team: noveritisEntry.Team, Language: noveritisEntry.Language}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposeNoveritis(r.Context(), noveritisEntry.Year, noveritisEntry.AscensionSunday, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
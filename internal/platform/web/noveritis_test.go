@@ -0,0 +1,40 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestNoveritis(t *testing.T) {
+	t.Run("announces the feasts of the year, with the Ascension on Sunday when asked", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Noveritis, http.MethodPost, "/noveritis", `{"year": 2025, "ascension_sunday": true}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.True(strings.HasPrefix(gabc.Gabc, "Ir(h)mãos(h) ca(h)rís(g)si(g)mos,(g) (,)\n"))
+		is.True(strings.Contains(gabc.Gabc, "a(h) As(h)cen(h)são(h) do(h) Se(h)nhor,(h) no(h) di(h)a(h) pri(h)mei(h)ro(h) de(h) ju(g)nho;(g) (,)\n"))
+	})
+
+	t.Run("requires the year", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Noveritis, http.MethodPost, "/noveritis", `{"language": "pt"}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "year field is required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Noveritis, http.MethodPost, "/noveritis", `{"year": 1582}`)
+		is.Equal(response.Code, http.StatusBadRequest) // before the Gregorian calendar
+	})
+}
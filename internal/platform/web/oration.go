@@ -0,0 +1,50 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type OrationJSON struct {
+	Text     string          `json:"text"`
+	Stress   map[string]int  `json:"stress,omitempty"`
+	Atonic   map[string]bool `json:"atonic,omitempty"`
+	Explain  bool            `json:"explain,omitempty"`
+	Team     string          `json:"team,omitempty"`
+	Language string          `json:"language,omitempty"` // "pt" or "la", the language of the Amém
+}
+
+// Oration handles requests to generate GABC code for a given oration text, like the collect.
+func (h *GabcHandler) Oration(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var orationEntry OrationJSON
+	if err := json.NewDecoder(r.Body).Decode(&orationEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if orationEntry.Text == "" {
+		http.Error(w, "text field is required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: orationEntry.Stress, Atonic: orationEntry.Atonic, Explain: orationEntry.Explain, Team: orationEntry.Team, Language: orationEntry.Language}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	orationScore, err := h.serviceAPI.ComposeOration(r.Context(), orationEntry.Text, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(orationScore))
+}
@@ -0,0 +1,43 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestOration(t *testing.T) {
+	t.Run("composes the oration, answered by the Amen of its language", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Oration, http.MethodPost, "/oration", `{"text": "Na verdade, digno, justo.\n Por Cristo, nosso Senhor.", "language": "la"}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "Na(h) ver(h)da(h)de,(h) dig(h)no,(g) jus(f)to.(f) (:)\nPor(h) Cris(h)to,(h) nos(h)so(g) Se(h)nhor.(gf) (::)\n<c><sp>R/</sp></c> A(f)men.(f) (::)")
+	})
+
+	t.Run("requires the text", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Oration, http.MethodPost, "/oration", `{"language": "la"}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "text field is required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Oration, http.MethodPost, "/oration", `{"text": "Na verdade, digno, justo.\n Por Cristo, nosso Senhor.", "language": "en"}`)
+		is.Equal(response.Code, http.StatusBadRequest) // the Amen has no language "en"
+	})
+
+	t.Run("only accepts POST", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serveMock((*web.GabcHandler).Oration, http.MethodGet, "/oration", "").Code, http.StatusMethodNotAllowed)
+	})
+}
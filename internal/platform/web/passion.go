@@ -0,0 +1,55 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type PassionJSON struct {
+	Text    string          `json:"text"`            // roles introduced by "N.", "†" (or "+") and "S."
+	Split   bool            `json:"split,omitempty"` // whether to respond with the words of each role alone, too
+	Stress  map[string]int  `json:"stress,omitempty"`
+	Atonic  map[string]bool `json:"atonic,omitempty"`
+	Explain bool            `json:"explain,omitempty"`
+	Team    string          `json:"team,omitempty"`
+}
+
+type PassionGabcJSON struct {
+	GabcJSON
+	Singers map[string]string `json:"singers,omitempty"` // GABC of the words of each role alone, keyed by its marker
+}
+
+// Passion handles requests to generate GABC code for the Passion, sung by the narrator, Christ and the crowd.
+func (h *GabcHandler) Passion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var passionEntry PassionJSON
+	if err := json.NewDecoder(r.Body).Decode(&passionEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if passionEntry.Text == "" {
+		http.Error(w, "text field is required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: passionEntry.Stress, Atonic: passionEntry.Atonic, Explain: passionEntry.Explain, Team: passionEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposePassion(r.Context(), passionEntry.Text, passionEntry.Split, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, PassionGabcJSON{GabcJSON: gabcJSON(score.Score), Singers: score.Singers})
+}
@@ -0,0 +1,44 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestPassion(t *testing.T) {
+	t.Run("responds with the words of each singer alone when split", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Passion, http.MethodPost, "/passion", `{"text": "N. Na verdade:\nS. É justo?", "split": true}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.PassionGabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "<c><sp>N.</sp></c> Na(h) ver(g)da(f)de:(f) (::)(Z)\n\n<c><sp>S.</sp></c> É(h) jus(h)to?(i) (::)")
+		is.Equal(gabc.Singers, map[string]string{"N.": "<c><sp>N.</sp></c> Na(h) ver(g)da(f)de:(f) (::)", "S.": "<c><sp>S.</sp></c> É(h) jus(h)to?(i) (::)"})
+	})
+
+	t.Run("leaves the singers out unless split", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Passion, http.MethodPost, "/passion", `{"text": "+ Na verdade."}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.PassionGabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "<c><sp>+</sp></c> Na(f) ver(e)da(d)de.(d) (::)")
+		is.Equal(len(gabc.Singers), 0)
+	})
+
+	t.Run("requires the text", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Passion, http.MethodPost, "/passion", `{"split": true}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "text field is required\n")
+	})
+}
@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/sitesyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+func TestPreview(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	liturgicalPath := filepath.Join(dir, "liturgical.json")
+	userPath := filepath.Join(dir, "user.json")
+	notSyllabifiedPath := filepath.Join(dir, "not_syllabified.txt")
+	is.NoErr(os.WriteFile(liturgicalPath, []byte(`{"vossa": {"slashed": "vos/sa", "tonic_index": 1}, "glória": {"slashed": "gló/ri/a", "tonic_index": 1}, "e": {"slashed": "e", "tonic_index": 1}}`), 0644))
+	is.NoErr(os.WriteFile(userPath, []byte(`{"louvar": {"slashed": "lou/var", "tonic_index": 2}}`), 0644))
+	is.NoErr(os.WriteFile(notSyllabifiedPath, []byte(""), 0644))
+
+	syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+	is.NoErr(syllabifier.LoadSyllables())
+
+	gabcHandler := web.NewGabcHandler(service.NewGabcGenAPI(syllabifier), 0)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/preview", gabcHandler.Preview)
+	server := web.NewServer(web.ServerConfig{Port: 8080, DisableRateLimit: true}, mux)
+
+	request, _ := http.NewRequest(http.MethodPost, "/preview", strings.NewReader(`{"text": "Vossa glória louvar\n e louvar-vos xzqwyk"}`))
+	response := httptest.NewRecorder()
+	server.Handler.ServeHTTP(response, request)
+	is.Equal(response.Code, http.StatusOK)
+
+	var preview web.PreviewJSON
+	is.NoErr(json.NewDecoder(response.Body).Decode(&preview))
+	is.Equal(len(preview.Phrases), 2)
+
+	t.Run("shows the syllables, tonic and source of each word", func(t *testing.T) {
+		is := is.New(t)
+
+		glory := preview.Phrases[0].Words[1]
+		is.Equal(glory.Syllables, []string{"gló", "ri", "a"})
+		is.Equal(glory.TonicIndex, 1)
+		is.Equal(glory.Source, "liturgical")
+
+		is.Equal(preview.Phrases[0].Words[2].Source, "user")
+
+		praise := preview.Phrases[1].Words[1]
+		is.Equal(praise.Syllables, []string{"lou", "var", "-vos"})
+		is.Equal(praise.TonicIndex, 2)
+		is.Equal(praise.Source, "rules") // a clitic group is split by rule
+	})
+
+	t.Run("leaves function words atonic", func(t *testing.T) {
+		is := is.New(t)
+
+		and := preview.Phrases[1].Words[0]
+		is.True(and.Atonic)
+		is.Equal(and.TonicIndex, 0)
+		is.Equal(and.Source, "liturgical")
+	})
+
+	t.Run("flags the words that could not be syllabified", func(t *testing.T) {
+		is := is.New(t)
+
+		unknown := preview.Phrases[1].Words[2]
+		is.Equal(unknown.Word, "xzqwyk")
+		is.True(unknown.Failed)
+		is.True(unknown.Error != "")
+		is.Equal(unknown.Syllables, nil)
+	})
+}
+
+func TestPreviewWithoutDatabase(t *testing.T) {
+	is := is.New(t)
+
+	response := serveMock((*web.GabcHandler).Preview, http.MethodPost, "/preview", `{"text": "Na verdade"}`)
+	is.Equal(response.Code, http.StatusOK)
+
+	var preview web.PreviewJSON
+	is.NoErr(json.NewDecoder(response.Body).Decode(&preview))
+	is.Equal(preview.Phrases[0].Words[1].Source, "rules") // the mock, like the Latin syllabifier, keeps no entries to be looked up
+}
@@ -0,0 +1,52 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type PsalmJSON struct {
+	Tone     string          `json:"tone"` // like "8G", "1" or "per"
+	Text     string          `json:"text"` // one verse per line, marked with "*" at the mediant and "†" at the flex
+	Doxology bool            `json:"doxology,omitempty"`
+	Language string          `json:"language,omitempty"` // "pt" or "la", the language of the doxology
+	Stress   map[string]int  `json:"stress,omitempty"`
+	Atonic   map[string]bool `json:"atonic,omitempty"`
+	Explain  bool            `json:"explain,omitempty"`
+	Team     string          `json:"team,omitempty"`
+}
+
+// Psalm handles requests to generate GABC code for the verses of a psalm, sung on a Gregorian psalm tone.
+func (h *GabcHandler) Psalm(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var psalmEntry PsalmJSON
+	if err := json.NewDecoder(r.Body).Decode(&psalmEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if psalmEntry.Tone == "" || psalmEntry.Text == "" {
+		http.Error(w, "tone and text fields are required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: psalmEntry.Stress, Atonic: psalmEntry.Atonic, Explain: psalmEntry.Explain, Team: psalmEntry.Team, Language: psalmEntry.Language}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposePsalm(r.Context(), psalmEntry.Tone, psalmEntry.Text, psalmEntry.Doxology, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
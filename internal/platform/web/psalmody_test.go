@@ -0,0 +1,38 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+)
+
+func TestPsalm(t *testing.T) {
+	t.Run("composes the verses on the psalm tone", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Psalm, http.MethodPost, "/psalm", `{"tone": "8g", "text": "Na verdade é digno * e justo."}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "(c4) Na(g) ver(h)da(j)de(j) é(j) dig(k)no(j) *(:)\ne(j) jus(h)to.(g) (::)")
+	})
+
+	t.Run("requires the tone and the text", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Psalm, http.MethodPost, "/psalm", `{"text": "Na verdade é digno * e justo."}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "tone and text fields are required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Psalm, http.MethodPost, "/psalm", `{"tone": "8G", "text": "Na verdade é digno * e justo.", "doxology": true, "language": "en"}`)
+		is.Equal(response.Code, http.StatusBadRequest) // there is no doxology in "en"
+	})
+}
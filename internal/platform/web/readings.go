@@ -0,0 +1,83 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type ReadingJSON struct {
+	Tone    string          `json:"tone"` // "prophecy", "epistle" or "gospel"
+	Text    string          `json:"text"`
+	Stress  map[string]int  `json:"stress,omitempty"`
+	Atonic  map[string]bool `json:"atonic,omitempty"`
+	Explain bool            `json:"explain,omitempty"`
+	Team    string          `json:"team,omitempty"`
+}
+
+// Reading handles requests to generate GABC code for a reading, split into phrases at its punctuation.
+func (h *GabcHandler) Reading(w http.ResponseWriter, r *http.Request) {
+	h.composeReading(w, r, h.serviceAPI.ComposeReading)
+}
+
+// Announcement handles requests to generate GABC code for the announcement of a reading, like "Leitura do Livro do Profeta Isaías.".
+func (h *GabcHandler) Announcement(w http.ResponseWriter, r *http.Request) {
+	h.composeReading(w, r, h.serviceAPI.ComposeAnnouncement)
+}
+
+// composeReading decodes a reading request and responds with the GABC composed by the given service method.
+func (h *GabcHandler) composeReading(w http.ResponseWriter, r *http.Request, compose func(ctx context.Context, tone, text string, opts service.Options) (service.Score, error)) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var readingEntry ReadingJSON
+	if err := json.NewDecoder(r.Body).Decode(&readingEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if readingEntry.Tone == "" || readingEntry.Text == "" {
+		http.Error(w, "tone and text fields are required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: readingEntry.Stress, Atonic: readingEntry.Atonic, Explain: readingEntry.Explain, Team: readingEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := compose(r.Context(), readingEntry.Tone, readingEntry.Text, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
+
+// Acclamation responds with the acclamation that closes a reading of the tone given in the query, like "Palavra do Senhor." (GET ?tone=).
+func (h *GabcHandler) Acclamation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	tone := r.URL.Query().Get("tone")
+	if tone == "" {
+		http.Error(w, "tone query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	acclamation, err := h.serviceAPI.ReadingAcclamation(r.Context(), tone)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, GabcJSON{Gabc: acclamation})
+}
@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+	"github.com/ramon-reichert/gabcgen/internal/service/readings"
+)
+
+func TestReading(t *testing.T) {
+	t.Run("composes the reading on its tone", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Reading, http.MethodPost, "/reading", `{"tone": "epistle", "text": "Na verdade, é digno e justo. Digno e justo é?"}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "Na(h) ver(h)da(h)de,(g) (,)\né(h) dig(h)no(h) e(g) jus(f)to.(e) (:)\nDig(h)no(h) e(h) jus(h)to(g) é?(gh) (::)")
+	})
+
+	t.Run("requires the tone and the text", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Reading, http.MethodPost, "/reading", `{"text": "Na verdade."}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "tone and text fields are required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Reading, http.MethodPost, "/reading", `{"tone": "psalm", "text": "Na verdade."}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+	})
+}
+
+func TestAnnouncement(t *testing.T) {
+	is := is.New(t)
+
+	response := serveMock((*web.GabcHandler).Announcement, http.MethodPost, "/reading/announcement", `{"tone": "gospel", "text": "Por Cristo, nosso Senhor."}`)
+	is.Equal(response.Code, http.StatusOK)
+
+	var gabc web.GabcJSON
+	is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+	is.Equal(gabc.Gabc, readings.GospelGreeting+"\n<c><sp>V/</sp></c> Por(h) Cris(h)to,(h) nos(h)so(h) Se(f)nhor.(fg) (::) "+readings.GospelResponse)
+}
+
+func TestAcclamation(t *testing.T) {
+	t.Run("responds with the acclamation of the tone", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Acclamation, http.MethodGet, "/reading/acclamation?tone=gospel", "")
+		is.Equal(response.Code, http.StatusOK)
+
+		acclamation, err := readings.Acclamation(readings.Gospel)
+		is.NoErr(err)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, acclamation)
+	})
+
+	t.Run("requires the tone", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Acclamation, http.MethodGet, "/reading/acclamation", "")
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "tone query parameter is required\n")
+	})
+}
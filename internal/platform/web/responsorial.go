@@ -0,0 +1,51 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+type ResponsorialJSON struct {
+	Tone    string          `json:"tone"`    // "simple" or "festive", the tone of the stanzas
+	Refrain string          `json:"refrain"` // one line for each phrase
+	Stanzas string          `json:"stanzas"` // separated by blank lines, with one line for each phrase
+	Stress  map[string]int  `json:"stress,omitempty"`
+	Atonic  map[string]bool `json:"atonic,omitempty"`
+	Explain bool            `json:"explain,omitempty"`
+	Team    string          `json:"team,omitempty"`
+}
+
+// Responsorial handles requests to generate GABC code for a responsorial psalm, with the refrain repeated after each stanza.
+func (h *GabcHandler) Responsorial(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var psalmEntry ResponsorialJSON
+	if err := json.NewDecoder(r.Body).Decode(&psalmEntry); err != nil {
+		http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if psalmEntry.Tone == "" || psalmEntry.Refrain == "" || psalmEntry.Stanzas == "" {
+		http.Error(w, "tone, refrain and stanzas fields are required", http.StatusBadRequest)
+		return
+	}
+
+	opts := service.Options{Stress: psalmEntry.Stress, Atonic: psalmEntry.Atonic, Explain: psalmEntry.Explain, Team: psalmEntry.Team}
+	if team := teamFromContext(r.Context()); team != "" {
+		opts.Team = team
+	}
+
+	score, err := h.serviceAPI.ComposeResponsorial(r.Context(), psalmEntry.Tone, psalmEntry.Refrain, psalmEntry.Stanzas, opts)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	responseJSON(w, http.StatusOK, gabcJSON(score))
+}
@@ -0,0 +1,39 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+	"github.com/ramon-reichert/gabcgen/internal/service/responsorial"
+)
+
+func TestResponsorial(t *testing.T) {
+	t.Run("composes the refrain and the stanzas", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Responsorial, http.MethodPost, "/psalm/responsorial", `{"tone": "simple", "refrain": "Cristo, nosso Senhor.", "stanzas": "Por isso, é justo."}`)
+		is.Equal(response.Code, http.StatusOK)
+
+		var gabc web.GabcJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&gabc))
+		is.Equal(gabc.Gabc, "<c><sp>R/</sp></c> Cris(f)to,(h) nos(h)so(h) Se(g)nhor.(gf) (::)(Z)\n\nPor(h) is(h)so,(h) é(g) jus(f)to.(f) (::) "+responsorial.Repeat)
+	})
+
+	t.Run("requires the tone, the refrain and the stanzas", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Responsorial, http.MethodPost, "/psalm/responsorial", `{"tone": "simple", "refrain": "Cristo, nosso Senhor."}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+		is.Equal(response.Body.String(), "tone, refrain and stanzas fields are required\n")
+	})
+
+	t.Run("returns error from GabcGen", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serveMock((*web.GabcHandler).Responsorial, http.MethodPost, "/psalm/responsorial", `{"tone": "solemn", "refrain": "Cristo, nosso Senhor.", "stanzas": "Por isso, é justo."}`)
+		is.Equal(response.Code, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,150 @@
+// Package web is the http layer adapter for the gabcgen service.
+package web
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+type TeamService interface {
+	ExportTeamDictionary(ctx context.Context, team string) ([]words.Entry, error)
+	ImportTeamDictionary(ctx context.Context, team string, entries []words.Entry, replace bool) error
+	TeamDictionaryDiff(ctx context.Context, team string) ([]words.TeamDifference, error)
+}
+
+type TeamHandler struct {
+	teamAPI TeamService
+}
+
+func NewTeamHandler(service TeamService) TeamHandler {
+	return TeamHandler{
+		teamAPI: service,
+	}
+}
+
+type ImportDictionaryJSON struct {
+	Entries []ReviewedWordJSON `json:"entries"`
+	Replace bool               `json:"replace,omitempty"` // whether the imported entries replace the whole dictionary instead of being added to it
+}
+
+type TeamDifferenceJSON struct {
+	Word   string     `json:"word"`
+	Status string     `json:"status"` // "added", "changed" or "same"
+	Team   EntryJSON  `json:"team"`
+	Shared *EntryJSON `json:"shared,omitempty"` // missing when the word is not in the shared databases
+}
+
+type teamKey struct{}
+
+// TeamKeys selects the team of the requests carrying one of the given API keys in the "X-API-Key" header.
+// Requests without the header go on without a team, while unknown keys are refused.
+func TeamKeys(keys map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			given := r.Header.Get("X-API-Key")
+			if given == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			for key, team := range keys {
+				if subtle.ConstantTimeCompare([]byte(given), []byte(key)) == 1 {
+					next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), teamKey{}, team)))
+					return
+				}
+			}
+
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		})
+	}
+}
+
+// teamFromContext returns the team selected by the API key of the request, if any.
+func teamFromContext(ctx context.Context) string {
+	team, _ := ctx.Value(teamKey{}).(string)
+	return team
+}
+
+// Dictionary exports the dictionary of the team of the API key (GET) or imports entries into it (POST).
+func (h *TeamHandler) Dictionary(w http.ResponseWriter, r *http.Request) {
+	team := teamFromContext(r.Context())
+	if team == "" {
+		http.Error(w, "an X-API-Key header of a team is required", http.StatusUnauthorized)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		entries, err := h.teamAPI.ExportTeamDictionary(r.Context(), team)
+		if err != nil {
+			handleError(err, w)
+			return
+		}
+
+		list := make([]EntryJSON, 0, len(entries))
+		for _, e := range entries {
+			list = append(list, entryJSON(e))
+		}
+
+		responseJSON(w, http.StatusOK, list)
+
+	case http.MethodPost:
+		var imported ImportDictionaryJSON
+		if err := json.NewDecoder(r.Body).Decode(&imported); err != nil {
+			http.Error(w, "invalid JSON body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entries := make([]words.Entry, 0, len(imported.Entries))
+		for _, e := range imported.Entries {
+			entries = append(entries, words.Entry{Word: e.Word, Slashed: e.Slashed, TonicIndex: e.TonicIndex})
+		}
+
+		if err := h.teamAPI.ImportTeamDictionary(r.Context(), team, entries, imported.Replace); err != nil {
+			handleError(err, w)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// DictionaryDiff shows how each entry of the dictionary of the team of the API key differs from the shared databases (GET).
+func (h *TeamHandler) DictionaryDiff(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	team := teamFromContext(r.Context())
+	if team == "" {
+		http.Error(w, "an X-API-Key header of a team is required", http.StatusUnauthorized)
+		return
+	}
+
+	diff, err := h.teamAPI.TeamDictionaryDiff(r.Context(), team)
+	if err != nil {
+		handleError(err, w)
+		return
+	}
+
+	list := make([]TeamDifferenceJSON, 0, len(diff))
+	for _, d := range diff {
+		j := TeamDifferenceJSON{Word: d.Word, Status: d.Status, Team: entryJSON(d.Team)}
+		if d.Status != words.DiffAdded {
+			shared := entryJSON(d.Shared)
+			j.Shared = &shared
+		}
+
+		list = append(list, j)
+	}
+
+	responseJSON(w, http.StatusOK, list)
+}
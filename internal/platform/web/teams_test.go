@@ -0,0 +1,114 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/sitesyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/teamdictionary"
+	"github.com/ramon-reichert/gabcgen/internal/platform/web"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+func TestTeamDictionaries(t *testing.T) {
+	is := is.New(t)
+
+	dir := t.TempDir()
+	liturgicalPath := filepath.Join(dir, "liturgical.json")
+	userPath := filepath.Join(dir, "user.json")
+	notSyllabifiedPath := filepath.Join(dir, "not_syllabified.txt")
+	is.NoErr(os.WriteFile(liturgicalPath, []byte(`{"rubrica": {"slashed": "ru/bri/ca", "tonic_index": 2}, "vossa": {"slashed": "vos/sa", "tonic_index": 1}, "glória": {"slashed": "gló/ri/a", "tonic_index": 1}}`), 0644))
+	is.NoErr(os.WriteFile(userPath, []byte("{}"), 0644))
+	is.NoErr(os.WriteFile(notSyllabifiedPath, []byte(""), 0644))
+
+	syllabifier := sitesyllabifier.NewSyllabifier(liturgicalPath, userPath, notSyllabifiedPath)
+	is.NoErr(syllabifier.LoadSyllables())
+
+	teams := teamdictionary.NewStore(filepath.Join(dir, "teams"))
+	is.NoErr(teams.LoadDictionaries())
+
+	gen := service.NewGabcGenAPI(syllabifier)
+	gen.Teams = teams
+
+	teamHandler := web.NewTeamHandler(gen)
+	teamKeys := web.TeamKeys(map[string]string{"key-of-the-parish": "parish"})
+	mux := http.NewServeMux()
+	mux.Handle("/team/dictionary", teamKeys(http.HandlerFunc(teamHandler.Dictionary)))
+	mux.Handle("/team/dictionary/diff", teamKeys(http.HandlerFunc(teamHandler.DictionaryDiff)))
+	server := web.NewServer(web.ServerConfig{Port: 8080, DisableRateLimit: true}, mux)
+
+	serve := func(method, target, body, key string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(method, target, strings.NewReader(body))
+		if key != "" {
+			request.Header.Set("X-API-Key", key)
+		}
+		response := httptest.NewRecorder()
+		server.Handler.ServeHTTP(response, request)
+		return response
+	}
+
+	t.Run("requires the API key of a team", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serve(http.MethodGet, "/team/dictionary", "", "").Code, http.StatusUnauthorized)
+		is.Equal(serve(http.MethodGet, "/team/dictionary", "", "wrong").Code, http.StatusUnauthorized)
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serve(http.MethodPost, "/team/dictionary", `{"entries": [{"word": "rubrica", "slashed": "ru/bri/ka", "tonic_index": 1}]}`, "key-of-the-parish").Code, http.StatusBadRequest)
+	})
+
+	t.Run("imports and exports the dictionary of the team", func(t *testing.T) {
+		is := is.New(t)
+		is.Equal(serve(http.MethodPost, "/team/dictionary", `{"entries": [{"word": "Rubrica", "slashed": "ru/bri/ca", "tonic_index": 1}, {"word": "cruzmaltino", "slashed": "cruz/mal/ti/no", "tonic_index": 3}]}`, "key-of-the-parish").Code, http.StatusNoContent)
+
+		response := serve(http.MethodGet, "/team/dictionary", "", "key-of-the-parish")
+		is.Equal(response.Code, http.StatusOK)
+
+		var list []web.EntryJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&list))
+		is.Equal(len(list), 2)
+		is.Equal(list[0].Word, "cruzmaltino")
+		is.Equal(list[1].Word, "rubrica") // kept in lower case
+		is.Equal(list[1].Source, "team")
+
+		_, err := os.Stat(filepath.Join(dir, "teams", "parish.json"))
+		is.NoErr(err) // saved right away
+	})
+
+	t.Run("shows how the team dictionary differs from the shared data", func(t *testing.T) {
+		is := is.New(t)
+
+		response := serve(http.MethodGet, "/team/dictionary/diff", "", "key-of-the-parish")
+		is.Equal(response.Code, http.StatusOK)
+
+		var diff []web.TeamDifferenceJSON
+		is.NoErr(json.NewDecoder(response.Body).Decode(&diff))
+		is.Equal(len(diff), 2)
+		is.Equal(diff[0].Status, "added")
+		is.True(diff[0].Shared == nil)
+		is.Equal(diff[1].Status, "changed")
+		is.Equal(diff[1].Shared.TonicIndex, 2)
+		is.Equal(diff[1].Team.TonicIndex, 1)
+	})
+
+	t.Run("the team dictionary is looked up before the shared one", func(t *testing.T) {
+		is := is.New(t)
+
+		text := "Vossa glória rubrica,\n vossa glória rubrica,\n vossa glória rubrica."
+
+		shared, err := gen.ComposePreface(t.Context(), "", text, service.Options{Explain: true})
+		is.NoErr(err)
+		is.Equal(shared.Explain[0], "VOS/sa GLÓ/ri/a ru/BRI/ca,")
+
+		team, err := gen.ComposePreface(t.Context(), "", text, service.Options{Explain: true, Team: "parish"})
+		is.NoErr(err)
+		is.Equal(team.Explain[0], "VOS/sa GLÓ/ri/a RU/bri/ca,")
+	})
+}
@@ -0,0 +1,70 @@
+// Package cadence attaches the notes of a reciting tone to the syllables of a phrase, so each tone only has to hold its own notes.
+package cadence
+
+import (
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// Cadence holds the notes of a cadence, attached from the end of a phrase back to its last accent.
+type Cadence struct {
+	After   string   // syllables after the last accent
+	Tonic   string   // last accented syllable
+	Oxytone string   // last accented syllable, when it also ends the phrase
+	Before  []string // syllables right before the last accent, the nearest first
+}
+
+// Sing attaches the cadence to the syllables from the end of the phrase back to its last accent, the starting notes, if any,
+// to the first syllables that are left, and the reciting note to the others.
+// A phrase without any accent, made only of function words, has no cadence.
+func Sing(syllables []*words.Syllable, start []string, recite string, c Cadence) error {
+
+	// Read syllables starting from the end
+	i := len(syllables) - 1
+
+	if i < 0 {
+		return gabcErrors.ErrShortPhrase
+	}
+
+	tonic := i
+	for tonic >= 0 && !syllables[tonic].IsTonic {
+		tonic--
+	}
+
+	if tonic >= 0 {
+		// Add GABC code to the last unstressed syllables
+		for ; i > tonic; i-- {
+			syllables[i].GABC = string(syllables[i].Char) + c.After
+		}
+
+		// Add GABC code to the last tonic syllable, from oxytone or from non oxytone
+		if tonic == len(syllables)-1 {
+			syllables[i].GABC = string(syllables[i].Char) + c.Oxytone
+		} else {
+			syllables[i].GABC = string(syllables[i].Char) + c.Tonic
+		}
+		i--
+
+		// Add GABC code to the syllables that prepare the cadence
+		for _, note := range c.Before {
+			if i < 0 {
+				break
+			}
+
+			syllables[i].GABC = string(syllables[i].Char) + note
+			i--
+		}
+	}
+
+	// Add GABC code to the reciting syllables, and the starting notes to the first ones
+	for ; i >= 0; i-- {
+		if i < len(start) {
+			syllables[i].GABC = string(syllables[i].Char) + start[i]
+			continue
+		}
+
+		syllables[i].GABC = string(syllables[i].Char) + recite
+	}
+
+	return nil
+}
@@ -0,0 +1,388 @@
+// Package phrases handle musical phrases composed of words.Syllable structs.
+// Phrases can be typed according to the Mass part.
+package phrases
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Normalization is a word of the text that is sung as other words, like "40" sung as "quarenta".
+type Normalization struct {
+	Original string // the word as it is in the text, kept for display
+	Sung     string // the words that are syllabified and sung
+}
+
+// Gender is the grammatical gender a number agrees with, taken from the noun after it.
+type Gender int
+
+const (
+	Masculine     Gender = iota // also the gender of the numbers that count, with no noun after them
+	Feminine                    // like "duas horas"
+	UnknownGender               // the language cannot tell the gender of the noun
+)
+
+// Expansions is the table used by a language to expand numbers, abbreviations and ordinals into sung words.
+type Expansions struct {
+	Abbreviations map[string]string                         // abbreviations with their dots, like "Sto."
+	Cardinal      func(n int, g Gender) (string, bool)      // spells out a number agreeing with the gender, returning false when it cannot
+	Ordinal       func(n int, feminine bool) (string, bool) // spells out an ordinal number, returning false when it cannot
+	GenderOf      func(noun string) Gender                  // tells the gender of the noun after a number
+	RegnalOrdinal int                                       // Roman numerals after names, like "João XXIII", are read as ordinals up to this number and as cardinals above it
+}
+
+// LanguageExpansions are the expansion tables of each language.
+var LanguageExpansions = map[string]Expansions{
+	"pt": {
+		Abbreviations: map[string]string{
+			"S.": "São", "Sto.": "Santo", "Sta.": "Santa", "Sr.": "Senhor", "Sra.": "Senhora",
+			"Pe.": "Padre", "D.": "Dom", "Fr.": "Frei", "Ir.": "Irmão", "Mons.": "Monsenhor",
+		},
+		Cardinal:      cardinalPt,
+		Ordinal:       ordinalPt,
+		GenderOf:      genderPt,
+		RegnalOrdinal: 10,
+	},
+	"la": {
+		Abbreviations: map[string]string{
+			"S.": "Sanctus", "B.": "Beatus", "BMV": "Beatae Mariae Virginis",
+		},
+		Cardinal:      cardinalLa,
+		Ordinal:       ordinalLa,
+		GenderOf:      genderLa,
+		RegnalOrdinal: 1000,
+	},
+}
+
+var (
+	ordinalRe = regexp.MustCompile(`^(\d+)\.?([ºª°])$`)
+	digitsRe  = regexp.MustCompile(`^\d+$`)
+	romanRe   = regexp.MustCompile(`^M{0,3}(CM|CD|D?C{0,3})(XC|XL|L?X{0,3})(IX|IV|V?I{0,3})$`)
+)
+
+// normalize expands the numbers, abbreviations and ordinals of the words of a text into sung words, following the table of its language.
+// It returns the sung words, the expansions made and the words that look like numbers but could not be expanded.
+func normalize(fields []string, language string) ([]string, []Normalization, []string) {
+	table, ok := LanguageExpansions[language]
+	if !ok {
+		return fields, nil, nil
+	}
+
+	var sung []string
+	var normalized []Normalization
+	var failed []string
+
+	for i, field := range fields {
+		prefix, core, suffix := splitPunctuation(field)
+
+		afterName := i > 0 && isName(fields[i-1])
+
+		// A number agrees with the noun right after it, unless a punctuation mark keeps them apart
+		gender := Masculine
+		if suffix == "" && i+1 < len(fields) {
+			gender = table.GenderOf(fields[i+1])
+		}
+
+		expanded, isNumber, ok := table.expand(core, afterName, gender)
+		if !ok && !isNumber && strings.HasSuffix(core, ".") { // a dot ending the sentence, like in "40." or "1ª."
+			core = strings.TrimSuffix(core, ".")
+			suffix = "." + suffix
+			expanded, isNumber, ok = table.expand(core, afterName, Masculine)
+		}
+		if !ok {
+			if isNumber {
+				failed = append(failed, field)
+			}
+			sung = append(sung, field)
+			continue
+		}
+
+		expanded = prefix + expanded + suffix
+		normalized = append(normalized, Normalization{Original: field, Sung: expanded})
+		sung = append(sung, strings.Fields(expanded)...)
+	}
+
+	return sung, normalized, failed
+}
+
+// expand expands a single word without its punctuation, telling also whether it was a number.
+// Cardinal numbers agree with the given gender, while the regnal ones are read after a name, always masculine.
+func (table Expansions) expand(core string, afterName bool, gender Gender) (expanded string, isNumber, ok bool) {
+	if s, ok := table.Abbreviations[core]; ok {
+		return s, false, true
+	}
+
+	if m := ordinalRe.FindStringSubmatch(core); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return "", true, false
+		}
+
+		s, ok := table.Ordinal(n, m[2] == "ª")
+		return s, true, ok
+	}
+
+	if digitsRe.MatchString(core) {
+		n, err := strconv.Atoi(core)
+		if err != nil {
+			return "", true, false
+		}
+
+		s, ok := table.Cardinal(n, gender)
+		return s, true, ok
+	}
+
+	if afterName && core != "" && romanRe.MatchString(core) {
+		n := roman(core)
+		if n <= table.RegnalOrdinal {
+			s, ok := table.Ordinal(n, false)
+			return s, true, ok
+		}
+
+		s, ok := table.Cardinal(n, Masculine)
+		return s, true, ok
+	}
+
+	return "", false, false
+}
+
+// splitPunctuation separates the punctuation around a word, keeping the dot of abbreviations and ordinals with the word.
+func splitPunctuation(field string) (prefix, core, suffix string) {
+	core = strings.TrimLeftFunc(field, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	prefix = field[:len(field)-len(core)]
+
+	trimmed := strings.TrimRightFunc(core, func(r rune) bool { return strings.ContainsRune(",;:!?)»”\"'", r) })
+	suffix = core[len(trimmed):]
+
+	return prefix, trimmed, suffix
+}
+
+// isName tells whether a word looks like a proper name, so a Roman numeral after it is a regnal number.
+func isName(word string) bool {
+	runes := []rune(word)
+	if len(runes) == 0 || !unicode.IsUpper(runes[0]) {
+		return false
+	}
+
+	return strings.ToUpper(word) != word // words in capitals are not names, but text written in capitals
+}
+
+// roman converts a valid Roman numeral into its value.
+func roman(s string) int {
+	values := map[rune]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+	runes := []rune(s)
+	n := 0
+
+	for i, r := range runes {
+		v := values[r]
+		if i+1 < len(runes) && v < values[runes[i+1]] {
+			n -= v
+		} else {
+			n += v
+		}
+	}
+
+	return n
+}
+
+var (
+	unitsPt    = []string{"zero", "um", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez", "onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove"}
+	tensPt     = []string{"", "", "vinte", "trinta", "quarenta", "cinquenta", "sessenta", "setenta", "oitenta", "noventa"}
+	hundredsPt = []string{"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos", "seiscentos", "setecentos", "oitocentos", "novecentos"}
+
+	ordinalUnitsPt    = []string{"", "primeiro", "segundo", "terceiro", "quarto", "quinto", "sexto", "sétimo", "oitavo", "nono"}
+	ordinalTensPt     = []string{"", "décimo", "vigésimo", "trigésimo", "quadragésimo", "quinquagésimo", "sexagésimo", "septuagésimo", "octogésimo", "nonagésimo"}
+	ordinalHundredsPt = []string{"", "centésimo", "ducentésimo", "trecentésimo", "quadringentésimo", "quingentésimo", "sexcentésimo", "septingentésimo", "octingentésimo", "nongentésimo"}
+)
+
+// masculineInAPt are the nouns ending in "a" that are masculine, which would otherwise be taken as feminine.
+var masculineInAPt = map[string]bool{
+	"dia": true, "dias": true, "papa": true, "papas": true, "profeta": true, "profetas": true, "patriarca": true, "patriarcas": true,
+	"evangelista": true, "evangelistas": true, "salmista": true, "salmistas": true, "poema": true, "poemas": true, "tema": true, "temas": true,
+	"problema": true, "problemas": true, "sistema": true, "sistemas": true, "planeta": true, "planetas": true, "mapa": true, "mapas": true,
+}
+
+// feminineNounsPt are the feminine nouns that do not end like one.
+var feminineNounsPt = map[string]bool{
+	"vez": true, "vezes": true, "mulher": true, "mulheres": true, "noite": true, "noites": true, "tarde": true, "tardes": true,
+	"mãe": true, "mães": true, "lei": true, "leis": true, "fé": true, "cruz": true, "cruzes": true, "luz": true, "luzes": true,
+	"paz": true, "parte": true, "partes": true, "ordem": true, "ordens": true, "árvore": true, "árvores": true, "fonte": true, "fontes": true,
+}
+
+// feminineEndingsPt are the endings of the nouns that are feminine, with the exceptions above.
+var feminineEndingsPt = []string{"a", "as", "ã", "ãs", "ção", "ções", "dade", "dades", "gem", "gens"}
+
+// genderPt tells the gender of a Portuguese noun by its ending, like "horas", or by the lists of the nouns that do not follow it.
+func genderPt(noun string) Gender {
+	_, noun, _ = splitPunctuation(strings.ToLower(noun))
+
+	switch {
+	case masculineInAPt[noun]:
+		return Masculine
+	case feminineNounsPt[noun]:
+		return Feminine
+	}
+
+	for _, e := range feminineEndingsPt {
+		if strings.HasSuffix(noun, e) {
+			return Feminine
+		}
+	}
+
+	return Masculine
+}
+
+// cardinalPt spells out a number in Portuguese, up to 999999, agreeing with the gender of the noun after it, like "duas horas".
+func cardinalPt(n int, g Gender) (string, bool) {
+	s, ok := masculineCardinalPt(n)
+	if !ok || g != Feminine {
+		return s, ok
+	}
+
+	words := strings.Fields(s)
+	for i, w := range words {
+		switch {
+		case w == "um":
+			words[i] = "uma"
+		case w == "dois":
+			words[i] = "duas"
+		case strings.HasSuffix(w, "entos"): // "duzentos" to "novecentos", but not "cento"
+			words[i] = strings.TrimSuffix(w, "os") + "as"
+		}
+	}
+
+	return strings.Join(words, " "), true
+}
+
+// masculineCardinalPt spells out a number in Portuguese, up to 999999, in the masculine, which is also the one used to count.
+func masculineCardinalPt(n int) (string, bool) {
+	switch {
+	case n < 0 || n > 999999:
+		return "", false
+	case n < 20:
+		return unitsPt[n], true
+	case n < 100:
+		if n%10 == 0 {
+			return tensPt[n/10], true
+		}
+		return tensPt[n/10] + " e " + unitsPt[n%10], true
+	case n == 100:
+		return "cem", true
+	case n < 1000:
+		if n%100 == 0 {
+			return hundredsPt[n/100], true
+		}
+		rest, _ := masculineCardinalPt(n % 100)
+		return hundredsPt[n/100] + " e " + rest, true
+	}
+
+	thousands := "mil"
+	if n/1000 > 1 {
+		t, _ := masculineCardinalPt(n / 1000)
+		thousands = t + " mil"
+	}
+
+	if n%1000 == 0 {
+		return thousands, true
+	}
+
+	rest, _ := masculineCardinalPt(n % 1000)
+	if n%1000 < 100 || n%100 == 0 {
+		return thousands + " e " + rest, true
+	}
+
+	return thousands + " " + rest, true
+}
+
+// ordinalPt spells out an ordinal number in Portuguese, up to 999.
+func ordinalPt(n int, feminine bool) (string, bool) {
+	if n < 1 || n > 999 {
+		return "", false
+	}
+
+	var parts []string
+	for _, p := range []string{ordinalHundredsPt[n/100], ordinalTensPt[n%100/10], ordinalUnitsPt[n%10]} {
+		if p == "" {
+			continue
+		}
+
+		if feminine {
+			p = strings.TrimSuffix(p, "o") + "a"
+		}
+		parts = append(parts, p)
+	}
+
+	return strings.Join(parts, " "), true
+}
+
+var (
+	unitsLa = []string{"", "unus", "duo", "tres", "quattuor", "quinque", "sex", "septem", "octo", "novem", "decem", "undecim", "duodecim", "tredecim", "quattuordecim", "quindecim", "sedecim", "septendecim", "duodeviginti", "undeviginti"}
+	tensLa  = []string{"", "", "viginti", "triginta", "quadraginta", "quinquaginta", "sexaginta", "septuaginta", "octoginta", "nonaginta"}
+
+	ordinalUnitsLa = []string{"", "primus", "secundus", "tertius", "quartus", "quintus", "sextus", "septimus", "octavus", "nonus", "decimus", "undecimus", "duodecimus", "tertius decimus", "quartus decimus", "quintus decimus", "sextus decimus", "septimus decimus", "duodevicesimus", "undevicesimus"}
+	ordinalTensLa  = []string{"", "", "vicesimus", "tricesimus", "quadragesimus", "quinquagesimus", "sexagesimus", "septuagesimus", "octogesimus", "nonagesimus"}
+)
+
+// genderLa cannot tell the gender of a Latin noun by its ending, which is shared by the declensions, like "hora" and "sæcula".
+func genderLa(noun string) Gender {
+	return UnknownGender
+}
+
+// feminineUnitsLa are the feminine forms of the units that change with the gender.
+var feminineUnitsLa = map[int]string{1: "una", 2: "duae"}
+
+// cardinalLa spells out a number in Latin, up to 99. The numbers ending in one, two or three change with the gender,
+// so they are only spelled out when it is known; the neuter, as in "tria sæcula", is not spelled out.
+func cardinalLa(n int, g Gender) (string, bool) {
+	if n < 1 || n > 99 {
+		return "", false
+	}
+
+	unit := n % 10
+	if n < 20 {
+		unit = n
+	}
+
+	if unit >= 1 && unit <= 3 && g == UnknownGender {
+		return "", false
+	}
+
+	u := unitsLa[unit]
+	if f, ok := feminineUnitsLa[unit]; ok && g == Feminine {
+		u = f
+	}
+
+	switch {
+	case n < 20:
+		return u, true
+	case n%10 == 0:
+		return tensLa[n/10], true
+	}
+
+	return tensLa[n/10] + " " + u, true
+}
+
+// ordinalLa spells out an ordinal number in Latin, up to 99.
+func ordinalLa(n int, feminine bool) (string, bool) {
+	var s string
+
+	switch {
+	case n < 1 || n > 99:
+		return "", false
+	case n < 20:
+		s = ordinalUnitsLa[n]
+	case n%10 == 0:
+		s = ordinalTensLa[n/10]
+	default:
+		s = ordinalTensLa[n/10] + " " + ordinalUnitsLa[n%10]
+	}
+
+	if feminine {
+		s = strings.ReplaceAll(s+" ", "us ", "a ")
+		s = strings.TrimSpace(s)
+	}
+
+	return s, true
+}
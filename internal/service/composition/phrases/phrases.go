@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode"
 
 	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
 	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
@@ -16,6 +17,19 @@ type Phrase struct {
 	Syllables   []*words.Syllable // syllables of the phrase
 	Syllabifier words.Syllabifier // Syllabifier to be used to syllabify the words of the phrase
 	Directives  []Directive       // possible singing directives may come between parentheses and are not to be sung. They are removed from the text before the syllabification and should be put back again after the melody is applied.
+	Stress      map[string]int    // tonic index chosen by the user for homographs of the text, keyed by lower case word
+	Language    string            // language of the text, choosing its function words; DefaultLanguage if empty
+	Atonic      map[string]bool   // overrides of the function words of the language, keyed by lower case word: true makes a word atonic, false keeps its stress
+	Warnings    []Warning         // choices made while building the syllables that the user should confirm
+	Normalized  []Normalization   // numbers, abbreviations and ordinals of the text that are sung as other words
+}
+
+// Warning flags a choice made while building the syllables of a phrase that the user should confirm.
+type Warning struct {
+	Word     string   // the word as it is in the text
+	Phrase   string   // text of the phrase holding the word
+	Message  string   // what is uncertain
+	Readings []string // known readings of the word, as syllables with the tonic one in upper case, the chosen one first
 }
 
 type Directive struct {
@@ -31,11 +45,12 @@ func New(text string) *Phrase {
 }
 
 // BuildPhraseSyllables populates a Phrase.Syllables iterating over each word of the Phrase.
+// Numbers, abbreviations and ordinals are expanded into sung words first, while the Phrase.Text keeps their original spelling.
 func (ph *Phrase) BuildPhraseSyllables(ctx context.Context) error {
-	words := strings.Fields(ph.Text)
+	fields, contexts := ph.prepareWords()
 
-	for _, v := range words {
-		syllables, err := ph.classifyWordSyllables(ctx, v)
+	for i, v := range fields {
+		syllables, err := ph.classifyWordSyllables(ctx, v, contexts[i])
 		if err != nil {
 			return fmt.Errorf("building Phrase Syllables: %w ", err)
 		}
@@ -46,23 +61,106 @@ func (ph *Phrase) BuildPhraseSyllables(ctx context.Context) error {
 	return nil
 }
 
+// prepareWords expands the words of the Phrase into the sung ones, giving the context of each one of them.
+func (ph *Phrase) prepareWords() ([]string, []words.Context) {
+	fields, normalized, failed := normalize(strings.Fields(ph.Text), ph.language())
+	ph.Normalized = append(ph.Normalized, normalized...)
+
+	for _, f := range failed {
+		ph.Warnings = append(ph.Warnings, Warning{
+			Word:    f,
+			Phrase:  ph.Text,
+			Message: "number that could not be spelled out: write it in words to have it sung",
+		})
+	}
+
+	letters := make([]string, len(fields)) // lower case letters of each word, to give context to homographs
+
+	for i, v := range fields {
+		w := words.New(v)
+		if w.ParseWord() == nil {
+			letters[i] = w.Letters()
+		}
+	}
+
+	contexts := make([]words.Context, len(fields))
+	for i := range fields {
+		contexts[i] = words.Context{Before: letters[:i], After: letters[i+1:], Override: ph.Stress[letters[i]]}
+	}
+
+	return fields, contexts
+}
+
+// SungWords lists the words of the Phrase as they are sung, with numbers and abbreviations expanded.
+// Directives should be extracted before, so the rubrics are not taken as sung words.
+func (ph *Phrase) SungWords() []string {
+	fields, _ := ph.prepareWords()
+	return fields
+}
+
 // classifyWordSyllables divides the syllables of a word and builds a Syllable struct from each one of them.
-func (ph *Phrase) classifyWordSyllables(ctx context.Context, word string) ([]*words.Syllable, error) {
+// Homographs are chosen by their context, with a warning when the choice is uncertain, and function words are left atonic.
+func (ph *Phrase) classifyWordSyllables(ctx context.Context, word string, c words.Context) ([]*words.Syllable, error) {
 	var wordSyllables []*words.Syllable
 	wordMaped := words.New(word)
 
 	if err := wordMaped.ParseWord(); err != gabcErrors.ErrNoLetters { // Early scape to avoid trying to syllabify a "non-letter word"
-		if err := wordMaped.Syllabify(ctx, ph.Syllabifier); err != nil {
+		candidates, certain, err := wordMaped.SyllabifyInContext(ctx, ph.Syllabifier, c)
+		if err != nil {
 			return wordSyllables, fmt.Errorf("classifying word syllables: %w", err)
 		}
+
+		if !certain {
+			ph.Warnings = append(ph.Warnings, Warning{
+				Word:     word,
+				Phrase:   ph.Text,
+				Message:  "homograph with more than one possible stress: confirm the chosen one or choose another",
+				Readings: readings(candidates),
+			})
+		}
+	}
+
+	if err := wordMaped.RecomposeWord(); err != nil {
+		return wordSyllables, fmt.Errorf("classifying word syllables: %w", err)
 	}
 
-	wordMaped.RecomposeWord()
 	wordSyllables = wordMaped.BuildWordSyllables()
 
+	if ph.isAtonic(wordMaped.Letters()) {
+		unstress(wordSyllables)
+	}
+
 	return wordSyllables, nil
 }
 
+// readings shows each candidate as its syllables with the tonic one in upper case, like "sa/BI/a".
+func readings(candidates []words.Candidate) []string {
+	var r []string
+
+	for _, c := range candidates {
+		syllables := strings.Split(c.Slashed, "/")
+		if c.TonicIndex > 0 && c.TonicIndex <= len(syllables) {
+			syllables[c.TonicIndex-1] = strings.ToUpper(syllables[c.TonicIndex-1])
+		}
+
+		r = append(r, strings.Join(syllables, "/"))
+	}
+
+	return r
+}
+
+// LastMark returns the last punctuation mark of a text, skipping closing quotes and brackets, or 0 if it ends with a letter or a digit.
+// The tones type their phrases by it, choosing the cadence each one ends with.
+func LastMark(text string) rune {
+	runes := []rune(strings.TrimRightFunc(text, func(r rune) bool { return strings.ContainsRune(`"”'’)]»`, r) }))
+
+	if len(runes) == 0 || unicode.IsLetter(runes[len(runes)-1]) || unicode.IsDigit(runes[len(runes)-1]) {
+		return 0
+	}
+
+	return runes[len(runes)-1]
+}
+
 // JoinSyllables is a helper function that joins the GABC of all Syllables in a Phrase and adds the end string to it.
 // It also attempts to put the directives back into the right place.
 func JoinSyllables(syl []*words.Syllable, end string, d []Directive) string {
@@ -1,9 +1,11 @@
 package phrases_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
 	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
 )
 
@@ -27,3 +29,147 @@ func TestExtractDirectives(t *testing.T) {
 		is.Equal(ph.Text, "before parentheses 1 after parentheses 1 and before 2 after 2")
 	})
 }
+
+func TestFunctionWords(t *testing.T) {
+	tonics := func(ph *phrases.Phrase) []bool {
+		var t []bool
+		for _, s := range ph.Syllables {
+			t = append(t, s.IsTonic)
+		}
+		return t
+	}
+
+	t.Run("function words of the language are atonic", func(t *testing.T) {
+		is := is.New(t)
+		ph := phrases.New("Na verdade, é digno e justo")
+		ph.Syllabifier = mocksyllabifier.NewSyllabifier()
+
+		is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+		is.Equal(tonics(ph), []bool{false, false, true, false, true, true, false, false, true, false}) // na ver-DA-de É DIG-no e JUS-to
+	})
+
+	t.Run("overrides keep a function word stressed", func(t *testing.T) {
+		is := is.New(t)
+		ph := phrases.New("Na verdade, é digno e justo")
+		ph.Syllabifier = mocksyllabifier.NewSyllabifier()
+		ph.Atonic = map[string]bool{"e": false, "digno": true}
+
+		is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+		is.Equal(tonics(ph), []bool{false, false, true, false, true, false, false, true, true, false}) // na ver-DA-de É dig-no E JUS-to
+	})
+
+	t.Run("the function words depend on the language", func(t *testing.T) {
+		is := is.New(t)
+		ph := phrases.New("Na verdade")
+		ph.Syllabifier = mocksyllabifier.NewSyllabifier()
+		ph.Language = "la"
+
+		is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+		is.Equal(tonics(ph), []bool{true, false, true, false})
+	})
+}
+
+func TestExplain(t *testing.T) {
+	is := is.New(t)
+	ph := phrases.New("Na verdade, é digno e justo")
+	ph.Syllabifier = mocksyllabifier.NewSyllabifier()
+
+	is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+	is.Equal(phrases.Explain(ph.Syllables), "Na ver/DA/de, É DIG/no e JUS/to")
+}
+
+// wordSyllabifier answers every word as a single tonic syllable, so only the words of the phrase matter.
+type wordSyllabifier struct{}
+
+func (wordSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	return word, 1, nil
+}
+
+func (wordSyllabifier) LoadSyllables() error { return nil }
+func (wordSyllabifier) SaveSyllables() error { return nil }
+
+func TestNormalize(t *testing.T) {
+	words := func(ph *phrases.Phrase) []string {
+		var w []string
+		for _, s := range ph.Syllables {
+			w = append(w, string(s.Char))
+		}
+		return w
+	}
+
+	for _, c := range []struct {
+		language, text string
+		sung           []string
+	}{
+		{"pt", "Jejuou 40 dias.", []string{"Jejuou", "quarenta", "dias."}},
+		{"pt", "Na 1ª leitura, 21º domingo", []string{"Na", "primeira", "leitura,", "vigésimo", "primeiro", "domingo"}},
+		{"pt", "S. José e Sta. Teresa", []string{"São", "José", "e", "Santa", "Teresa"}},
+		{"pt", "o Papa João XXIII e Pio X.", []string{"o", "Papa", "João", "vinte", "e", "três", "e", "Pio", "décimo."}},
+		{"pt", "Ano 2025", []string{"Ano", "dois", "mil", "e", "vinte", "e", "cinco"}},
+		{"pt", "Às 2 horas, 21 vezes", []string{"Às", "duas", "horas,", "vinte", "e", "uma", "vezes"}}, // cardinals agree with the noun after them
+		{"pt", "Os 200 dias de 2200 pessoas", []string{"Os", "duzentos", "dias", "de", "duas", "mil", "e", "duzentas", "pessoas"}},
+		{"pt", "o nosso bispo N.", []string{"o", "nosso", "bispo", "N."}}, // the placeholder of a name is not sung as a letter
+		{"la", "Psalmus 22", []string{"Psalmus", "viginti", "duo"}},
+		{"la", "Ioannes XXIII", []string{"Ioannes", "vicesimus", "tertius"}},
+	} {
+		t.Run(c.text, func(t *testing.T) {
+			is := is.New(t)
+			ph := phrases.New(c.text)
+			ph.Syllabifier = wordSyllabifier{}
+			ph.Language = c.language
+
+			is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+			is.Equal(words(ph), c.sung)
+			is.Equal(ph.Text, c.text) // the original spelling is kept
+		})
+	}
+
+	t.Run("keeps the original spelling of the expanded words", func(t *testing.T) {
+		is := is.New(t)
+		ph := phrases.New("Jejuou 40 dias.")
+		ph.Syllabifier = wordSyllabifier{}
+
+		is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+		is.Equal(ph.Normalized, []phrases.Normalization{{Original: "40", Sung: "quarenta"}})
+	})
+
+	t.Run("warns about numbers that cannot be spelled out", func(t *testing.T) {
+		is := is.New(t)
+		ph := phrases.New("Ano 1000000")
+		ph.Syllabifier = wordSyllabifier{}
+
+		is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+		is.Equal(len(ph.Warnings), 1)
+		is.Equal(ph.Warnings[0].Word, "1000000")
+	})
+
+	t.Run("does not guess the gender of a Latin noun", func(t *testing.T) {
+		is := is.New(t)
+		ph := phrases.New("3 sæcula") // "tria", in the neuter
+		ph.Syllabifier = wordSyllabifier{}
+		ph.Language = "la"
+
+		is.NoErr(ph.BuildPhraseSyllables(context.Background()))
+		is.Equal(len(ph.Warnings), 1)
+		is.Equal(ph.Warnings[0].Word, "3")
+	})
+}
+
+func TestSanitize(t *testing.T) {
+	is := is.New(t)
+
+	text, changes := phrases.Sanitize("Vossa glória,\r\n“Santo” — ex­ulta\r\n")
+	is.Equal(text, "Vossa glória,\n\"Santo\" - exulta\n")
+	is.Equal(changes, []phrases.Change{
+		{Reason: "Windows line ending", From: "\r\n", To: "\n", Count: 2},
+		{Reason: "non-breaking space", From: " ", To: " ", Count: 1},
+		{Reason: "soft hyphen", From: "­", Count: 1},
+		{Reason: "typographic quote", From: "“", To: "\"", Count: 1},
+		{Reason: "typographic quote", From: "”", To: "\"", Count: 1},
+		{Reason: "em dash", From: "—", To: "-", Count: 1},
+		{Reason: "decomposed accents", Count: 1},
+	})
+
+	_, changes = phrases.Sanitize("Vossa glória")
+	is.Equal(len(changes), 0) // clean texts are left as they are
+}
@@ -0,0 +1,75 @@
+// Package phrases handle musical phrases composed of words.Syllable structs.
+// Phrases can be typed according to the Mass part.
+package phrases
+
+import (
+	"context"
+	"errors"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// WordPreview shows how a word of a Phrase is going to be syllabified, before any melody is applied.
+type WordPreview struct {
+	Word       string   // the sung word as it is in the text
+	Syllables  []string // syllables of the word, with its punctuation
+	TonicIndex int      // index of the tonic syllable starting from 1, or 0 when the word is atonic in the phrase
+	Atonic     bool     // whether the word is a function word without stress of its own
+	Source     string   // where the syllables come from: one of the words.Source constants
+	Readings   []string // known readings of a homograph, the chosen one first
+	Err        error    // why the word could not be syllabified
+}
+
+// PreviewWords syllabifies each word of the Phrase like BuildPhraseSyllables, but goes on when a word fails,
+// flagging it with its error instead. Words without letters are left out.
+func (ph *Phrase) PreviewWords(ctx context.Context) []WordPreview {
+	var preview []WordPreview
+	fields, contexts := ph.prepareWords()
+
+	for i, v := range fields {
+		w := words.New(v)
+		if errors.Is(w.ParseWord(), gabcErrors.ErrNoLetters) {
+			continue
+		}
+
+		p := WordPreview{Word: v, Atonic: ph.isAtonic(w.Letters())}
+
+		source, err := w.Source(ph.Syllabifier)
+		if err != nil {
+			p.Err = err
+			preview = append(preview, p)
+			continue
+		}
+
+		warnings := len(ph.Warnings)
+		syllables, err := ph.classifyWordSyllables(ctx, v, contexts[i])
+		if err != nil {
+			p.Err = err
+			preview = append(preview, p)
+			continue
+		}
+
+		if _, ok := ph.Syllabifier.(words.Lookuper); !ok {
+			source = words.SourceRules // a syllabifier that keeps no entries, like the Latin one, builds every word by its rules
+		} else if source == "" {
+			source = words.SourceRemote // it was not stored, so it was just fetched
+		}
+		p.Source = source
+
+		for j, s := range syllables {
+			p.Syllables = append(p.Syllables, string(s.Char))
+			if s.IsTonic {
+				p.TonicIndex = j + 1
+			}
+		}
+
+		if len(ph.Warnings) > warnings {
+			p.Readings = ph.Warnings[len(ph.Warnings)-1].Readings
+		}
+
+		preview = append(preview, p)
+	}
+
+	return preview
+}
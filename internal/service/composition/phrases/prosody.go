@@ -0,0 +1,95 @@
+// Package phrases handle musical phrases composed of words.Syllable structs.
+// Phrases can be typed according to the Mass part.
+package phrases
+
+import (
+	"strings"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// DefaultLanguage is the language of the phrases that do not set one.
+const DefaultLanguage = "pt"
+
+// FunctionWords are the words of each language that have no stress of their own in a phrase, like articles, prepositions and conjunctions,
+// so the cadences fall on the lexical accents around them.
+var FunctionWords = map[string]map[string]bool{
+	"pt": set(
+		"o", "a", "os", "as", "um", "uns", // articles
+		"de", "do", "da", "dos", "das", "em", "no", "na", "nos", "nas", "ao", "aos", "à", "às", // prepositions and contractions
+		"por", "pelo", "pela", "pelos", "pelas", "com", "sem", "sob", "num", "numa", "dum", "duma", "pra", "para",
+		"e", "ou", "mas", "que", "se", "nem", // conjunctions
+		"me", "te", "lhe", "lhes", "vos", // unstressed pronouns
+	),
+	"la": set(
+		"a", "ab", "ad", "cum", "de", "e", "ex", "in", "per", "pro", "sub", // prepositions
+		"et", "ac", "sed", "nec", "ut", "qui", "quae", "quod", // conjunctions and relatives
+	),
+}
+
+func set(words ...string) map[string]bool {
+	s := make(map[string]bool, len(words))
+	for _, w := range words {
+		s[w] = true
+	}
+
+	return s
+}
+
+// isAtonic tells whether a word, in lower case letters, has no stress in the phrase.
+// The overrides of the phrase win over the function words of its language, and a stress chosen by the user always keeps the word stressed.
+func (ph *Phrase) isAtonic(word string) bool {
+	if _, ok := ph.Stress[word]; ok {
+		return false
+	}
+
+	if atonic, ok := ph.Atonic[word]; ok {
+		return atonic
+	}
+
+	return FunctionWords[ph.language()][word]
+}
+
+// language returns the language of the phrase, or the DefaultLanguage if it has none.
+func (ph *Phrase) language() string {
+	if ph.Language == "" {
+		return DefaultLanguage
+	}
+
+	return ph.Language
+}
+
+// unstress removes the primary and secondary stresses of all syllables of a word.
+func unstress(syllables []*words.Syllable) {
+	for _, s := range syllables {
+		s.IsTonic = false
+		s.IsSecondary = false
+	}
+}
+
+// Explain shows how the syllables of a phrase are stressed, separating them with slashes,
+// with the primary stresses in upper case and the secondary ones after a "ˌ", like "ˌglo/ri/ˌfi/ca/ÇÃO".
+func Explain(syl []*words.Syllable) string {
+	var b strings.Builder
+
+	for i, s := range syl {
+		switch {
+		case s.IsTonic:
+			b.WriteString(strings.ToUpper(string(s.Char)))
+		case s.IsSecondary:
+			b.WriteString("ˌ" + string(s.Char))
+		default:
+			b.WriteString(string(s.Char))
+		}
+
+		if i < len(syl)-1 {
+			if s.IsLast {
+				b.WriteString(" ")
+			} else {
+				b.WriteString("/")
+			}
+		}
+	}
+
+	return b.String()
+}
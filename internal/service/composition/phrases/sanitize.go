@@ -0,0 +1,70 @@
+// Package phrases handle musical phrases composed of words.Syllable structs.
+// Phrases can be typed according to the Mass part.
+package phrases
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Change is a kind of change made to the incoming text by Sanitize, with how many times it was made.
+type Change struct {
+	Reason string // why the text was changed
+	From   string // what was replaced, empty when it cannot be shown, like the decomposed accents
+	To     string // what it was replaced with, empty when it was removed
+	Count  int
+}
+
+// sanitizing are the replacements made by Sanitize, in order, with their reasons.
+var sanitizing = []struct {
+	from, to, reason string
+}{
+	{"\r\n", "\n", "Windows line ending"},
+	{"\r", "\n", "old Mac line ending"},
+	{"\u00a0", " ", "non-breaking space"},
+	{"\u202f", " ", "narrow non-breaking space"},
+	{"\u2007", " ", "figure space"},
+	{"\u2009", " ", "thin space"},
+	{"\u200a", " ", "hair space"},
+	{"\u00ad", "", "soft hyphen"},
+	{"\u200b", "", "zero width space"},
+	{"\u200c", "", "zero width non-joiner"},
+	{"\u200d", "", "zero width joiner"},
+	{"\u2060", "", "word joiner"},
+	{"\ufeff", "", "byte order mark"},
+	{"\u200e", "", "left-to-right mark"},
+	{"\u200f", "", "right-to-left mark"},
+	{"\u2018", "'", "typographic quote"},
+	{"\u2019", "'", "typographic quote"},
+	{"\u201a", "'", "typographic quote"},
+	{"\u201c", "\"", "typographic quote"},
+	{"\u201d", "\"", "typographic quote"},
+	{"\u201e", "\"", "typographic quote"},
+	{"\u2013", "-", "en dash"},
+	{"\u2014", "-", "em dash"},
+	{"\u2026", "...", "ellipsis"},
+}
+
+// Sanitize prepares a text pasted from PDFs and word processors to be distributed into phrases.
+// It maps line endings, spaces and typographic punctuation to plain ones, strips invisible characters and recomposes decomposed accents (NFC),
+// returning the sanitized text and every kind of change made to it.
+func Sanitize(text string) (string, []Change) {
+	var changes []Change
+
+	for _, s := range sanitizing {
+		if n := strings.Count(text, s.from); n > 0 {
+			text = strings.ReplaceAll(text, s.from, s.to)
+			changes = append(changes, Change{Reason: s.reason, From: s.from, To: s.to, Count: n})
+		}
+	}
+
+	if !norm.NFC.IsNormalString(text) {
+		composed := norm.NFC.String(text)
+		changes = append(changes, Change{Reason: "decomposed accents", Count: utf8.RuneCountInString(text) - utf8.RuneCountInString(composed)})
+		text = composed
+	}
+
+	return text, changes
+}
@@ -0,0 +1,129 @@
+// Package words provides structures and methods to handle word syllabification and related metadata.
+package words
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// clitics are the unstressed pronouns attached to a verb with hyphens, with their syllables.
+var clitics = map[string]string{
+	"me": "me", "te": "te", "se": "se", "nos": "nos", "vos": "vos", "lhe": "lhe", "lhes": "lhes",
+	"o": "o", "a": "a", "os": "os", "as": "as",
+	"lo": "lo", "la": "la", "los": "los", "las": "las",
+	"no": "no", "na": "na", "nas": "nas",
+	"mo": "mo", "ma": "ma", "mos": "mos", "mas": "mas",
+	"to": "to", "ta": "ta", "tos": "tos", "tas": "tas",
+	"lho": "lho", "lha": "lha", "lhos": "lhos", "lhas": "lhas",
+}
+
+// mesoclisisEndings are the endings of the future and conditional tenses that follow the clitics in a mesoclisis,
+// with their syllables and stressed syllable.
+var mesoclisisEndings = map[string]Candidate{
+	"ei":    {Slashed: "ei", TonicIndex: 1},
+	"ás":    {Slashed: "ás", TonicIndex: 1},
+	"á":     {Slashed: "á", TonicIndex: 1},
+	"emos":  {Slashed: "e/mos", TonicIndex: 1},
+	"eis":   {Slashed: "eis", TonicIndex: 1},
+	"ão":    {Slashed: "ão", TonicIndex: 1},
+	"ia":    {Slashed: "i/a", TonicIndex: 1},
+	"ias":   {Slashed: "i/as", TonicIndex: 1},
+	"íamos": {Slashed: "í/a/mos", TonicIndex: 1},
+	"íeis":  {Slashed: "í/eis", TonicIndex: 1},
+	"iam":   {Slashed: "i/am", TonicIndex: 1},
+}
+
+// cliticGroup is a verb with enclitic or mesoclitic pronouns, like "louvar-vos" or "dá-lo-ei".
+type cliticGroup struct {
+	host     string   // the verb, or the verb stem of a mesoclisis
+	clitics  []string // the pronouns attached to the verb
+	ending   string   // the verbal ending of a mesoclisis, empty for an enclisis
+	original string   // the whole group as it is written
+}
+
+// parseCliticGroup splits a hyphenated word into a verb and its clitics.
+// It returns false for words that are not clitic groups, like compound words, which are syllabified as a whole.
+func parseCliticGroup(word string) (cliticGroup, bool) {
+	var parts []string
+	for _, part := range strings.Split(word, "-") {
+		parts = append(parts, strings.ToLower(strings.TrimFunc(part, func(r rune) bool { return !unicode.IsLetter(r) })))
+	}
+
+	if len(parts) < 2 || parts[0] == "" {
+		return cliticGroup{}, false
+	}
+
+	group := cliticGroup{host: parts[0], original: word}
+	rest := parts[1:]
+
+	if len(rest) > 1 {
+		if _, ok := mesoclisisEndings[rest[len(rest)-1]]; ok {
+			group.ending = rest[len(rest)-1]
+			rest = rest[:len(rest)-1]
+		}
+	}
+
+	for _, part := range rest {
+		if _, ok := clitics[part]; !ok {
+			return cliticGroup{}, false
+		}
+		group.clitics = append(group.clitics, part)
+	}
+
+	return group, true
+}
+
+// syllabify syllabifies the verb with the syllabifier and the clitics by themselves, joining them back into a single word.
+// The clitics are always unstressed, so the tonic syllable is the one of the verb, which falls on the ending in a mesoclisis.
+func (g cliticGroup) syllabify(ctx context.Context, syllabifier Syllabifier) (string, int, error) {
+	host, hostTonic, err := syllabifier.Syllabify(ctx, g.host)
+	if err != nil {
+		return "", 0, fmt.Errorf("syllabifying verb %v of %v: %w ", g.host, g.original, err)
+	}
+
+	parts := []string{host}
+	tonicIndex := hostTonic
+	for _, c := range g.clitics {
+		parts = append(parts, clitics[c])
+	}
+
+	if g.ending != "" {
+		ending := mesoclisisEndings[g.ending]
+		tonicIndex = strings.Count(strings.Join(parts, "/"), "/") + 1 + ending.TonicIndex
+		parts = append(parts, ending.Slashed)
+	}
+
+	return strings.Join(parts, "/"), tonicIndex, nil
+}
+
+// Source tells where the syllables of a parsed word come from, without fetching it: SourceRules for clitic groups,
+// or the database where it is stored, when the syllabifier can look words up. It is empty for words not stored yet.
+func (wMap *WordMaped) Source(syllabifier Syllabifier) (string, error) {
+	if _, ok := parseCliticGroup(wMap.word); ok {
+		return SourceRules, nil
+	}
+
+	lookuper, ok := syllabifier.(Lookuper)
+	if !ok {
+		return "", nil
+	}
+
+	_, database, err := lookuper.LookupEntry(string(wMap.justLetters))
+	if err != nil {
+		return "", fmt.Errorf("looking word %v up: %w", wMap.word, err)
+	}
+
+	return database, nil
+}
+
+// LookupLetters returns the letters of a parsed word as they are stored in the syllable databases:
+// the verb of a clitic group, whose clitics are syllabified by rule, or the whole word otherwise.
+func (wMap *WordMaped) LookupLetters() string {
+	if group, ok := parseCliticGroup(wMap.word); ok {
+		return group.host
+	}
+
+	return string(wMap.justLetters)
+}
@@ -0,0 +1,116 @@
+// Package words provides structures and methods to handle word syllabification and related metadata.
+package words
+
+import (
+	"context"
+	"slices"
+)
+
+// HomographSyllabifier is implemented by syllabifiers that know homographs: words with the same spelling and different stresses.
+type HomographSyllabifier interface {
+	Candidates(ctx context.Context, word string) ([]Candidate, error) // all known readings of a word, the default one first
+}
+
+type Candidate struct {
+	Slashed      string
+	TonicIndex   int
+	PartOfSpeech string   // "noun", "verb", "adjective"... used as a hint when choosing between candidates
+	Neighbours   []string // words that usually come near this reading
+}
+
+// Context holds what surrounds a word in the text, to choose between its candidates.
+type Context struct {
+	Before   []string // lower case words before the word, the nearest last
+	After    []string // lower case words after the word, the nearest first
+	Override int      // tonic index chosen by the user for this text, 0 when not given
+}
+
+const contextWindow = 3 // how many words around the homograph are looked at
+
+// Words that, coming right before a homograph, hint at its part of speech.
+var (
+	nounCues = []string{"o", "a", "os", "as", "um", "uma", "uns", "umas", "do", "da", "dos", "das", "no", "na", "nos", "nas", "ao", "à", "pelo", "pela",
+		"meu", "minha", "teu", "tua", "seu", "sua", "nosso", "nossa", "vosso", "vossa", "este", "esta", "esse", "essa", "aquele", "aquela", "tão", "mais", "muito", "muita"}
+	verbCues = []string{"eu", "tu", "ele", "ela", "nós", "vós", "eles", "elas", "se", "me", "te", "lhe", "lhes", "não", "que", "quem", "já", "também", "nunca"}
+)
+
+// ChooseCandidate chooses the reading of a homograph that best fits its context, reporting whether the choice is certain.
+// A user override always wins. Otherwise each neighbour word found around the homograph counts two points,
+// and a part of speech suggested by the word right before it counts one. Ties keep the default candidate and are uncertain.
+func ChooseCandidate(candidates []Candidate, c Context) (Candidate, bool) {
+	if len(candidates) == 0 {
+		return Candidate{}, false
+	}
+
+	if c.Override > 0 {
+		for _, cand := range candidates {
+			if cand.TonicIndex == c.Override {
+				return cand, true
+			}
+		}
+
+		// The user knows a stress that no candidate has, so the default syllables are kept with the chosen stress
+		chosen := candidates[0]
+		chosen.TonicIndex = c.Override
+		return chosen, true
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	around := append(lastN(c.Before, contextWindow), firstN(c.After, contextWindow)...)
+	previous := ""
+	if len(c.Before) > 0 {
+		previous = c.Before[len(c.Before)-1]
+	}
+
+	best, bestScore, secondScore := 0, -1, -1
+
+	for i, cand := range candidates {
+		score := 0
+
+		for _, w := range around {
+			if slices.Contains(cand.Neighbours, w) {
+				score += 2
+			}
+		}
+
+		switch cand.PartOfSpeech {
+		case "noun", "adjective":
+			if slices.Contains(nounCues, previous) {
+				score++
+			}
+		case "verb":
+			if slices.Contains(verbCues, previous) {
+				score++
+			}
+		}
+
+		if score > bestScore {
+			best, bestScore, secondScore = i, score, bestScore
+		} else if score > secondScore {
+			secondScore = score
+		}
+	}
+
+	if bestScore == secondScore {
+		return candidates[0], false
+	}
+
+	return candidates[best], true
+}
+
+func lastN(s []string, n int) []string {
+	if len(s) > n {
+		return s[len(s)-n:]
+	}
+	return s
+}
+
+func firstN(s []string, n int) []string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
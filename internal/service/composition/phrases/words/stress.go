@@ -0,0 +1,38 @@
+// Package words provides structures and methods to handle word syllabification and related metadata.
+package words
+
+// SecondaryStresses is the lexicon of words whose secondary stresses do not follow the rule of alternating back from the primary one,
+// like adverbs ending in "-mente", which keep the stress of the adjective they come from. The syllables are indexed from 1.
+var SecondaryStresses = map[string][]int{
+	"cordialmente":     {3},
+	"fielmente":        {2},
+	"espiritualmente":  {2, 5},
+	"filialmente":      {3},
+	"paternalmente":    {3},
+	"celestialmente":   {4},
+	"principalmente":   {3},
+	"universalmente":   {2, 4},
+	"sacramentalmente": {2, 4},
+}
+
+// secondaryStresses returns the syllables of a word with a secondary stress, indexed from 1.
+// Unless the word is in the lexicon, they alternate back from the primary stress, skipping the syllable right before it.
+func secondaryStresses(word string, syllables, tonicIndex int) map[int]bool {
+	secondary := make(map[int]bool)
+
+	if indexes, ok := SecondaryStresses[word]; ok {
+		for _, i := range indexes {
+			if i >= 1 && i <= syllables && i != tonicIndex {
+				secondary[i] = true
+			}
+		}
+
+		return secondary
+	}
+
+	for i := tonicIndex - 2; i >= 1; i -= 2 {
+		secondary[i] = true
+	}
+
+	return secondary
+}
@@ -0,0 +1,36 @@
+// Package words provides structures and methods to handle word syllabification and related metadata.
+package words
+
+// TeamDictionaries keeps the private syllabifications of each team, looked up before the shared databases,
+// so the regional pronunciation of a team does not override everyone else's.
+type TeamDictionaries interface {
+	TeamEntry(team, word string) (Entry, bool, error)                   // look a word up in the dictionary of a team
+	TeamEntries(team string) ([]Entry, error)                           // list the dictionary of a team, ordered by word
+	ImportTeamEntries(team string, entries []Entry, replace bool) error // add entries to the dictionary of a team, or replace it altogether
+}
+
+// Lookuper is implemented by syllable databases that can look a word up without fetching it when it is unknown.
+// Besides the entry, it tells the database where the word was found: SourceTeam, SourceUser, SourceLiturgical, or empty when it is unknown.
+type Lookuper interface {
+	LookupEntry(word string) (Entry, string, error)
+}
+
+// LiturgicalLookuper is implemented by syllable databases that can tell whether a word was reviewed into the liturgical database,
+// even when the user database also has it and is looked up first.
+type LiturgicalLookuper interface {
+	InLiturgical(word string) (bool, error)
+}
+
+// How an entry of a team dictionary differs from the shared databases.
+const (
+	DiffAdded   = "added"   // the word is not in the shared databases
+	DiffChanged = "changed" // the team syllabifies the word differently
+	DiffSame    = "same"    // the team entry is equal to the shared one
+)
+
+type TeamDifference struct {
+	Word   string
+	Status string // one of the Diff constants
+	Team   Entry
+	Shared Entry // zero when the word is not in the shared databases
+}
@@ -0,0 +1,69 @@
+// Package words provides structures and methods to handle word syllabification and related metadata.
+package words
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+)
+
+// ValidateSyllables checks that a slashed form spells the given word, with no empty syllables, and that the tonic index points to one of its syllables.
+func ValidateSyllables(word, slashed string, tonicIndex int) error {
+	syllables := strings.Split(slashed, "/")
+
+	for _, s := range syllables {
+		if s == "" {
+			return fmt.Errorf("validating %q as %q: empty syllable: %w", word, slashed, gabcErrors.ErrSlashedMismatch)
+		}
+	}
+
+	if strings.ToLower(strings.Join(syllables, "")) != strings.ToLower(word) {
+		return fmt.Errorf("validating %q as %q: %w", word, slashed, gabcErrors.ErrSlashedMismatch)
+	}
+
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			return fmt.Errorf("validating %q: non-letter %q: %w", word, r, gabcErrors.ErrSlashedMismatch)
+		}
+	}
+
+	if tonicIndex < 1 || tonicIndex > len(syllables) {
+		return fmt.Errorf("validating %q as %q with tonic index %v: %w", word, slashed, tonicIndex, gabcErrors.ErrTonicIndex)
+	}
+
+	return nil
+}
+
+// ValidationMode tells the syllabifiers what to do with the invalid entries found while loading their databases.
+type ValidationMode int
+
+const (
+	Strict  ValidationMode = iota // refuse to load databases with invalid entries
+	Lenient                       // quarantine the invalid entries, leaving them out of the lookups
+)
+
+type InvalidEntry struct {
+	Database   string // file or store holding the entry
+	Word       string
+	Slashed    string
+	TonicIndex int
+	Reason     error
+}
+
+// ValidationError collects all the invalid entries found in the syllable databases.
+type ValidationError struct {
+	Entries []InvalidEntry
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v invalid syllable entries:", len(e.Entries))
+
+	for _, v := range e.Entries {
+		fmt.Fprintf(&b, "\n\t%v: %v", v.Database, v.Reason) // the reason already names the word and its syllables
+	}
+
+	return b.String()
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 	"unicode"
 
 	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"golang.org/x/text/unicode/norm"
 )
 
 type Syllabifier interface {
@@ -20,12 +22,58 @@ type SyllabDb interface {
 	SaveSyllables() error //Save the syllables to a file
 }
 
+// ReviewQueue is implemented by syllable databases that keep the words that could not be syllabified, so they can be reviewed later.
+type ReviewQueue interface {
+	PendingWords() ([]PendingWord, error)                      // list the words waiting for review
+	ResolvePending(word, slashed string, tonicIndex int) error // store the reviewed syllables into the user database and remove the word from the queue
+	DismissPending(word string) error                          // remove a word that should not be syllabified from the queue
+}
+
+type PendingWord struct {
+	Word        string
+	Occurrences int       // how many times the syllabification failed
+	LastSeen    time.Time // when the syllabification failed for the last time
+}
+
+// Curator is implemented by syllable databases that can promote reviewed entries of the user database into the curated liturgical one.
+type Curator interface {
+	UnreviewedEntries() ([]Entry, error)                     // list the user entries waiting to be reviewed
+	ApproveEntry(word, slashed string, tonicIndex int) error // move a user entry into the liturgical database, optionally correcting it
+	Conflicts() ([]Conflict, error)                          // list the words syllabified differently in the user and liturgical databases
+	ResolveConflict(word, keep string) error                 // keep the syllabification of one of the databases (SourceUser or SourceLiturgical) and drop the other
+}
+
+// Sources of a syllabification, kept along with the entries as their provenance.
+const (
+	SourceLiturgical = "liturgical" // curated liturgical database
+	SourceUser       = "user"       // given by a user, like an answer to the review queue
+	SourceRemote     = "remote"     // fetched from the external website during runtime
+	SourceTeam       = "team"       // given by a team to its private dictionary
+	SourceRules      = "rules"      // built by the rules of this package, like the clitics attached to verbs
+)
+
+type Entry struct {
+	Word       string
+	Slashed    string
+	TonicIndex int
+	Source     string    // where the syllabification came from
+	UpdatedAt  time.Time // when it was stored
+	ReviewedAt time.Time // when it was approved into the liturgical database
+}
+
+type Conflict struct {
+	Word       string
+	User       Entry
+	Liturgical Entry
+}
+
 type Syllable struct {
-	Char    []rune
-	IsTonic bool
-	IsLast  bool   // Is it the last syllable of a word?
-	IsFirst bool   // Is it the first syllable of a word? If it is an oxytone, so IsLast AND IsFirst are true.
-	GABC    string // syllable text with the GABC code attached to it
+	Char        []rune
+	IsTonic     bool
+	IsSecondary bool   // Does it bear a secondary stress, like "glo" and "fi" in "glorificação"?
+	IsLast      bool   // Is it the last syllable of a word?
+	IsFirst     bool   // Is it the first syllable of a word? If it is an oxytone, so IsLast AND IsFirst are true.
+	GABC        string // syllable text with the GABC code attached to it
 }
 
 type WordMaped struct {
@@ -71,7 +119,20 @@ func (wMap *WordMaped) ParseWord() error {
 }
 
 // Syllabify takes a word and uses the Syllabifier to split it into syllables.
+// Verbs with hyphenated clitics are split, so each part is syllabified separately.
 func (wMap *WordMaped) Syllabify(ctx context.Context, syllabifier Syllabifier) error {
+	if group, ok := parseCliticGroup(wMap.word); ok {
+		slashed, tonicIndex, err := group.syllabify(ctx, syllabifier)
+		if err != nil {
+			return fmt.Errorf("syllabifying word %v: %w ", wMap.word, err)
+		}
+
+		wMap.slashedLetters = slashed
+		wMap.tonicIndex = tonicIndex
+
+		return nil
+	}
+
 	slashed, tonicIndex, err := syllabifier.Syllabify(ctx, string(wMap.justLetters))
 	if err != nil {
 		return fmt.Errorf("syllabifying word %v: %w ", wMap.word, err)
@@ -83,45 +144,119 @@ func (wMap *WordMaped) Syllabify(ctx context.Context, syllabifier Syllabifier) e
 	return nil
 }
 
+// SyllabifyInContext syllabifies a word that may be a homograph, choosing among its candidates with the given context.
+// It returns the candidates of a homograph and whether the chosen one is certain. Other words are syllabified as usual.
+func (wMap *WordMaped) SyllabifyInContext(ctx context.Context, syllabifier Syllabifier, c Context) ([]Candidate, bool, error) {
+	_, isGroup := parseCliticGroup(wMap.word)
+	homographs, ok := syllabifier.(HomographSyllabifier)
+	if !ok || isGroup {
+		err := wMap.Syllabify(ctx, syllabifier)
+		if c.Override > 0 {
+			wMap.tonicIndex = c.Override
+		}
+
+		return nil, true, err
+	}
+
+	candidates, err := homographs.Candidates(ctx, string(wMap.justLetters))
+	if err != nil {
+		return nil, false, fmt.Errorf("syllabifying word %v: %w ", wMap.word, err)
+	}
+
+	chosen, certain := ChooseCandidate(candidates, c)
+	wMap.slashedLetters = chosen.Slashed
+	wMap.tonicIndex = chosen.TonicIndex
+
+	if len(candidates) < 2 {
+		return nil, certain, nil
+	}
+
+	return candidates, certain, nil
+}
+
+// Letters returns the letters of the parsed word in lower case, as they are looked up in the syllable databases.
+func (wMap *WordMaped) Letters() string {
+	return string(wMap.justLetters)
+}
+
 // RecomposeWord takes a word with slashes and recomposes it with the original case and punctuation marks.
-func (wMap *WordMaped) RecomposeWord() {
+// The letters of the slashed word are verified one by one against the original letters, tolerating only case and accent differences,
+// so bad syllabifier data returns a MismatchErr instead of breaking the recomposition.
+func (wMap *WordMaped) RecomposeWord() error {
 	var recomposedWord []rune
 	runeSlashed := []rune(wMap.slashedLetters)
-	originalWordIndex := 0
 	slashedLettersIndex := 0
 
-	for originalWordIndex < len(wMap.originalRunes) {
+	for originalWordIndex, original := range wMap.originalRunes {
 
 		// Test if there is a ponctuation mark to put back into place
-		elem, ok := wMap.notLetters[originalWordIndex]
-		if ok {
+		if elem, ok := wMap.notLetters[originalWordIndex]; ok {
 			recomposedWord = append(recomposedWord, elem)
-		} else {
+			continue
+		}
 
-			// Retrieve the case of each letter
-			wasUpper, ok := wMap.upperLetters[originalWordIndex]
-			if ok {
-				runeSlashed[slashedLettersIndex] = unicode.ToUpper(wasUpper)
-			}
+		if slashedLettersIndex >= len(runeSlashed) {
+			return wMap.mismatch(fmt.Sprintf("missing letter %q", original))
+		}
 
+		if runeSlashed[slashedLettersIndex] == '/' {
+			return wMap.mismatch("empty syllable")
+		}
+
+		if !sameLetter(original, runeSlashed[slashedLettersIndex]) {
+			return wMap.mismatch(fmt.Sprintf("letter %q does not match %q", runeSlashed[slashedLettersIndex], original))
+		}
+
+		// Keep the original letter, with its case and accent
+		recomposedWord = append(recomposedWord, original)
+		slashedLettersIndex++
+
+		if slashedLettersIndex < len(runeSlashed) && runeSlashed[slashedLettersIndex] == '/' {
 			recomposedWord = append(recomposedWord, runeSlashed[slashedLettersIndex])
 			slashedLettersIndex++
 
-			if slashedLettersIndex < len(runeSlashed) && runeSlashed[slashedLettersIndex] == '/' {
-				recomposedWord = append(recomposedWord, runeSlashed[slashedLettersIndex])
-				slashedLettersIndex++
+			if slashedLettersIndex == len(runeSlashed) {
+				return wMap.mismatch("empty last syllable")
 			}
 		}
+	}
 
-		originalWordIndex++
+	if slashedLettersIndex < len(runeSlashed) {
+		return wMap.mismatch(fmt.Sprintf("extra letters %q", string(runeSlashed[slashedLettersIndex:])))
 	}
 
 	wMap.splittedSyllables = strings.Split(string(recomposedWord), "/") // using "/" instead of "-" to preserve syllables that use "-" to start speech
+
+	if len(wMap.justLetters) > 0 && (wMap.tonicIndex < 1 || wMap.tonicIndex > len(wMap.splittedSyllables)) {
+		return fmt.Errorf("recomposing word %q from %q with tonic index %v: %w", wMap.word, wMap.slashedLetters, wMap.tonicIndex, gabcErrors.ErrTonicIndex)
+	}
+
+	return nil
+}
+
+// mismatch builds the error returned when the slashed letters do not align with the original word.
+func (wMap *WordMaped) mismatch(reason string) error {
+	return gabcErrors.MismatchErr{Word: wMap.word, Slashed: wMap.slashedLetters, Reason: reason}
+}
+
+// sameLetter compares two letters ignoring case and accents.
+func sameLetter(a, b rune) bool {
+	return baseLetter(unicode.ToLower(a)) == baseLetter(unicode.ToLower(b))
+}
+
+// baseLetter strips the accents of a letter, returning the letter itself when it has none.
+func baseLetter(r rune) rune {
+	for _, d := range norm.NFD.String(string(r)) {
+		return d // the base letter comes first in the decomposition, followed by the combining accents
+	}
+
+	return r
 }
 
 // BuildWordSyllables builds a Syllable struct with metadata from each []rune representing a syllable
 func (wMap *WordMaped) BuildWordSyllables() []*Syllable {
 	var wordSyllables []*Syllable
+	secondary := secondaryStresses(string(wMap.justLetters), len(wMap.splittedSyllables), wMap.tonicIndex)
 
 	for i, v := range wMap.splittedSyllables {
 		s := &Syllable{Char: []rune(v)}
@@ -130,6 +265,10 @@ func (wMap *WordMaped) BuildWordSyllables() []*Syllable {
 			s.IsTonic = true
 		}
 
+		if secondary[i+1] {
+			s.IsSecondary = true
+		}
+
 		if i == 0 { // the first syllable
 			s.IsFirst = true
 		}
@@ -0,0 +1,205 @@
+package words_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// fixedSyllabifier answers every word with the same syllables, like a provider returning bad data.
+type fixedSyllabifier struct {
+	slashed string
+	tonic   int
+}
+
+func (f fixedSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	return f.slashed, f.tonic, nil
+}
+
+func (f fixedSyllabifier) LoadSyllables() error { return nil }
+func (f fixedSyllabifier) SaveSyllables() error { return nil }
+
+func TestRecomposeWord(t *testing.T) {
+	recompose := func(word, slashed string, tonic int) ([]*words.Syllable, error) {
+		wMap := words.New(word)
+		if err := wMap.ParseWord(); err != nil {
+			return nil, err
+		}
+
+		if err := wMap.Syllabify(context.Background(), fixedSyllabifier{slashed, tonic}); err != nil {
+			return nil, err
+		}
+
+		if err := wMap.RecomposeWord(); err != nil {
+			return nil, err
+		}
+
+		return wMap.BuildWordSyllables(), nil
+	}
+
+	t.Run("keeps the original case, accents and punctuation", func(t *testing.T) {
+		is := is.New(t)
+
+		syllables, err := recompose("-Glória,", "GLO/ri/a", 1)
+		is.NoErr(err)
+		is.Equal(len(syllables), 3)
+		is.Equal(string(syllables[0].Char), "-Gló")
+		is.Equal(string(syllables[2].Char), "a,")
+		is.True(syllables[0].IsTonic)
+	})
+
+	t.Run("returns a MismatchErr instead of panicking on bad provider data", func(t *testing.T) {
+		for _, c := range []struct{ word, slashed string }{
+			{"desconhecida", ""},        // unknown word answered with nothing, as the mock does
+			{"glória", "gló/ri"},        // missing letters
+			{"paz", "pa/zes"},           // extra letters
+			{"cordeiro", "cor/dei/rro"}, // different letter
+			{"santo", "/san/to"},        // empty syllable
+			{"santo", "san/to/"},        // empty last syllable
+		} {
+			is := is.New(t)
+
+			_, err := recompose(c.word, c.slashed, 1)
+
+			var mismatch gabcErrors.MismatchErr
+			is.True(errors.As(err, &mismatch))
+			is.Equal(mismatch.Word, c.word)
+			is.Equal(mismatch.Slashed, c.slashed)
+
+			var domainErr gabcErrors.DomainErr
+			is.True(errors.As(err, &domainErr)) // so the web layer answers with a bad request
+		}
+	})
+
+	t.Run("rejects tonic indexes outside the word", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := recompose("santo", "san/to", 3)
+		is.True(errors.Is(err, gabcErrors.ErrTonicIndex))
+	})
+}
+
+func TestChooseCandidate(t *testing.T) {
+	sabia := []words.Candidate{
+		{Slashed: "sa/bi/a", TonicIndex: 2, PartOfSpeech: "verb", Neighbours: []string{"eu", "já"}},
+		{Slashed: "sa/bi/a", TonicIndex: 1, PartOfSpeech: "adjective", Neighbours: []string{"virgem", "mulher"}},
+	}
+
+	t.Run("neighbouring words choose the reading", func(t *testing.T) {
+		is := is.New(t)
+
+		chosen, certain := words.ChooseCandidate(sabia, words.Context{Before: []string{"ó", "virgem"}})
+		is.True(certain)
+		is.Equal(chosen.TonicIndex, 1)
+	})
+
+	t.Run("the word before hints at the part of speech", func(t *testing.T) {
+		is := is.New(t)
+
+		chosen, certain := words.ChooseCandidate(sabia, words.Context{Before: []string{"ele", "não"}, After: []string{"o", "caminho"}})
+		is.True(certain)
+		is.Equal(chosen.TonicIndex, 2)
+	})
+
+	t.Run("no evidence keeps the default reading and is uncertain", func(t *testing.T) {
+		is := is.New(t)
+
+		chosen, certain := words.ChooseCandidate(sabia, words.Context{After: []string{"o", "caminho"}})
+		is.True(!certain)
+		is.Equal(chosen.TonicIndex, 2)
+	})
+
+	t.Run("the user override always wins", func(t *testing.T) {
+		is := is.New(t)
+
+		chosen, certain := words.ChooseCandidate(sabia, words.Context{Before: []string{"eu", "já"}, Override: 1})
+		is.True(certain)
+		is.Equal(chosen.TonicIndex, 1)
+	})
+}
+
+// verbSyllabifier knows only a few verbs, so the clitics must be syllabified apart from them.
+type verbSyllabifier map[string]words.Candidate
+
+func (v verbSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	c, ok := v[word]
+	if !ok {
+		return "", 0, errors.New("unknown word " + word)
+	}
+
+	return c.Slashed, c.TonicIndex, nil
+}
+
+func (v verbSyllabifier) LoadSyllables() error { return nil }
+func (v verbSyllabifier) SaveSyllables() error { return nil }
+
+func TestCliticGroups(t *testing.T) {
+	verbs := verbSyllabifier{
+		"louvar":    {Slashed: "lou/var", TonicIndex: 2},
+		"dá":        {Slashed: "dá", TonicIndex: 1},
+		"glorificá": {Slashed: "glo/ri/fi/cá", TonicIndex: 4},
+	}
+
+	for _, c := range []struct {
+		word      string
+		syllables []string
+		tonic     int
+	}{
+		{"louvar-vos", []string{"lou", "var", "-vos"}, 2},
+		{"glorificá-lo.", []string{"glo", "ri", "fi", "cá", "-lo."}, 4},
+		{"Dá-lo-ei", []string{"Dá", "-lo", "-ei"}, 3}, // the stress of the future tense falls on the ending
+	} {
+		t.Run(c.word, func(t *testing.T) {
+			is := is.New(t)
+
+			wMap := words.New(c.word)
+			is.NoErr(wMap.ParseWord())
+			is.NoErr(wMap.Syllabify(context.Background(), verbs))
+			is.NoErr(wMap.RecomposeWord())
+
+			syllables := wMap.BuildWordSyllables()
+			is.Equal(len(syllables), len(c.syllables))
+			for i, s := range syllables {
+				is.Equal(string(s.Char), c.syllables[i])
+				is.Equal(s.IsTonic, i+1 == c.tonic)
+			}
+		})
+	}
+
+	t.Run("compound words are syllabified as a whole", func(t *testing.T) {
+		is := is.New(t)
+
+		wMap := words.New("bem-aventurados")
+		is.NoErr(wMap.ParseWord())
+		is.True(wMap.Syllabify(context.Background(), verbs) != nil) // looked up as "bemaventurados"
+	})
+}
+
+func TestSecondaryStress(t *testing.T) {
+	for _, c := range []struct {
+		word, slashed string
+		tonic         int
+		secondary     []bool
+	}{
+		{"glorificação", "glo/ri/fi/ca/ção", 5, []bool{true, false, true, false, false}},  // alternating back from the primary stress
+		{"cordialmente", "cor/di/al/men/te", 4, []bool{false, false, true, false, false}}, // the lexicon keeps the stress of "cordial"
+		{"senhor", "se/nhor", 2, []bool{false, false}},                                    // too short for a secondary stress
+	} {
+		t.Run(c.word, func(t *testing.T) {
+			is := is.New(t)
+
+			wMap := words.New(c.word)
+			is.NoErr(wMap.ParseWord())
+			is.NoErr(wMap.Syllabify(context.Background(), fixedSyllabifier{c.slashed, c.tonic}))
+			is.NoErr(wMap.RecomposeWord())
+
+			for i, s := range wMap.BuildWordSyllables() {
+				is.Equal(s.IsSecondary, c.secondary[i])
+			}
+		})
+	}
+}
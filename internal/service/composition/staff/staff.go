@@ -2,16 +2,36 @@
 package staff
 
 const (
+	Re  = "(i)"
 	Do  = "(h)"
 	Si  = "(g)"
 	La  = "(f)"
 	Sol = "(e)"
+	Fa  = "(d)"
 
+	ReDo    = "(ih)"
+	DoRe    = "(hi)"
 	LaSi    = "(fg)"
 	LaSiLa  = "(fgf)"
 	SiLa    = "(gf)"
+	DoSi    = "(hg)"
+	DoLa    = "(hf)"
+	SiDo    = "(gh)"
 	LaSol   = "(fe)"
 	SolLa   = "(ef)"
+	SolFa   = "(ed)"
 	LaSiDo  = "(fgh)"
 	SolLaSi = "(efg)"
 )
+
+// Amen is the answer of the people after the orations, in each language, sung on the reciting note of the people.
+var Amen = map[string]string{
+	"pt": "<c><sp>R/</sp></c> A(f)mém.(f) (::)",
+	"la": "<c><sp>R/</sp></c> A(f)men.(f) (::)",
+}
+
+// SolemnAmen is the answer of the people after the final doxology and the solemn announcements, in each language.
+var SolemnAmen = map[string]string{
+	"pt": "<c><sp>R/</sp></c> A(g)mém.(ghg) (::)",
+	"la": "<c><sp>R/</sp></c> A(g)men.(ghg) (::)",
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// WordCoverage is how many times a word appears in a corpus and the database that already has its syllables.
+type WordCoverage struct {
+	Word     string
+	Count    int
+	Database string // words.SourceLiturgical, words.SourceUser, or empty when no database has the word
+}
+
+// Coverage tells how much of a corpus of texts the syllable databases cover, counting every occurrence of the words.
+type Coverage struct {
+	Texts      int            // texts with at least one word
+	Words      []WordCoverage // the most frequent first
+	Total      int
+	Liturgical int
+	User       int
+	Missing    int
+}
+
+// CorpusCoverage reads the texts the same way they are composed and looks each word up in the syllable databases,
+// without fetching the missing ones, so curators know which words to add first.
+func (gen GabcGen) CorpusCoverage(ctx context.Context, texts []string) (Coverage, error) {
+	lookuper, ok := gen.Syllabifier.(words.Lookuper)
+	if !ok {
+		return Coverage{}, gabcErrors.ErrNoLookup
+	}
+
+	counts := make(map[string]int)
+
+	var coverage Coverage
+	for _, text := range texts {
+		text, _ = phrases.Sanitize(text)
+
+		paragraphs, err := phrases.DistributeText(text)
+		if errors.Is(err, gabcErrors.ErrNoText) {
+			continue
+		}
+		if err != nil {
+			return Coverage{}, fmt.Errorf("reading corpus: %w", err)
+		}
+
+		found := false
+		for _, p := range paragraphs {
+			for _, ph := range p.Phrases {
+				if err := ph.ExtractDirectives(); err != nil {
+					log.Println(err)
+				}
+
+				for _, v := range ph.SungWords() {
+					w := words.New(v)
+					if w.ParseWord() != nil {
+						continue
+					}
+
+					counts[w.LookupLetters()]++
+					found = true
+				}
+			}
+		}
+
+		if found {
+			coverage.Texts++
+		}
+	}
+
+	for word, count := range counts {
+		_, database, err := lookuper.LookupEntry(word)
+		if err != nil {
+			return Coverage{}, fmt.Errorf("looking word %v up: %w", word, err)
+		}
+
+		// A reviewed word counts as liturgical even when a user entry is looked up first
+		if liturgical, ok := lookuper.(words.LiturgicalLookuper); ok && database == words.SourceUser {
+			found, err := liturgical.InLiturgical(word)
+			if err != nil {
+				return Coverage{}, fmt.Errorf("looking word %v up in the liturgical database: %w", word, err)
+			}
+
+			if found {
+				database = words.SourceLiturgical
+			}
+		}
+
+		coverage.Words = append(coverage.Words, WordCoverage{Word: word, Count: count, Database: database})
+		coverage.Total += count
+
+		switch database {
+		case words.SourceLiturgical:
+			coverage.Liturgical += count
+		case words.SourceUser:
+			coverage.User += count
+		default:
+			coverage.Missing += count
+		}
+	}
+
+	slices.SortFunc(coverage.Words, func(a, b WordCoverage) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Word, b.Word))
+	})
+
+	return coverage, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// UnreviewedEntries lists the user entries, with their provenance, that were not approved into the liturgical database yet.
+func (gen GabcGen) UnreviewedEntries(ctx context.Context) ([]words.Entry, error) {
+	curator, ok := gen.Syllabifier.(words.Curator)
+	if !ok {
+		return nil, gabcErrors.ErrNoCurator
+	}
+
+	entries, err := curator.UnreviewedEntries()
+	if err != nil {
+		return nil, fmt.Errorf("listing unreviewed entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ApproveEntry promotes a reviewed user entry into the liturgical database. An empty slashed form approves the entry as it is.
+func (gen GabcGen) ApproveEntry(ctx context.Context, word, slashed string, tonicIndex int) error {
+	curator, ok := gen.Syllabifier.(words.Curator)
+	if !ok {
+		return gabcErrors.ErrNoCurator
+	}
+
+	word = strings.ToLower(strings.TrimSpace(word))
+	slashed = strings.ToLower(strings.TrimSpace(slashed))
+
+	if err := curator.ApproveEntry(word, slashed, tonicIndex); err != nil {
+		return fmt.Errorf("approving entry %v: %w", word, err)
+	}
+
+	return nil
+}
+
+// SyllableConflicts lists the words syllabified differently in the user and liturgical databases.
+func (gen GabcGen) SyllableConflicts(ctx context.Context) ([]words.Conflict, error) {
+	curator, ok := gen.Syllabifier.(words.Curator)
+	if !ok {
+		return nil, gabcErrors.ErrNoCurator
+	}
+
+	conflicts, err := curator.Conflicts()
+	if err != nil {
+		return nil, fmt.Errorf("listing conflicts: %w", err)
+	}
+
+	return conflicts, nil
+}
+
+// ResolveConflict keeps the syllabification of the chosen database ("user" or "liturgical") for a conflicting word.
+func (gen GabcGen) ResolveConflict(ctx context.Context, word, keep string) error {
+	curator, ok := gen.Syllabifier.(words.Curator)
+	if !ok {
+		return gabcErrors.ErrNoCurator
+	}
+
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	if err := curator.ResolveConflict(word, keep); err != nil {
+		return fmt.Errorf("resolving conflict of %v: %w", word, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/eucharistic"
+)
+
+// GenerateEucharistic attaches GABC code to each syllable of a part of an Eucharistic Prayer, named by the prayer, "I" to "IV",
+// and by the part: "epiclesis", "institution" or "doxology". The text may be left empty to sing the one of the Missal,
+// which is kept for the doxology and for the Latin epiclesis of each prayer.
+func (gen GabcGen) GenerateEucharistic(ctx context.Context, prayer, part, linedText string) (string, error) {
+	score, err := gen.ComposeEucharistic(ctx, prayer, part, linedText, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeEucharistic works like GenerateEucharistic, taking the per-text options and returning the warnings along with the GABC.
+func (gen GabcGen) ComposeEucharistic(ctx context.Context, prayer, part, linedText string, opts Options) (Score, error) {
+	pr, err := eucharistic.ParsePrayer(prayer)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Eucharistic Prayer: %w", err)
+	}
+
+	pa, err := eucharistic.ParsePart(part)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Eucharistic Prayer: %w", err)
+	}
+
+	language := opts.Language
+	if language == "" {
+		language = phrases.DefaultLanguage
+	}
+
+	if strings.TrimSpace(linedText) == "" {
+		linedText, err = eucharistic.MissalText(pr, pa, language)
+		if err != nil {
+			return Score{}, fmt.Errorf("generating Eucharistic Prayer: %w", err)
+		}
+	}
+
+	newParagraphs, linedText, score, err := gen.buildParagraphs(ctx, linedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Eucharistic Prayer: %w", err)
+	}
+
+	ep := eucharistic.New(pr, pa, language, linedText)
+
+	if err := ep.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Eucharistic Prayer: %w", err)
+	}
+
+	if err := ep.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Eucharistic Prayer: %w", err)
+	}
+
+	score.GABC = ep.ComposedGABC
+
+	return score, nil
+}
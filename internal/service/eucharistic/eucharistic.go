@@ -0,0 +1,289 @@
+// Package eucharistic handles specific phrase types that compose the melody of the chanted parts of the Eucharistic Prayers.
+package eucharistic
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+// Prayer is one of the four Eucharistic Prayers of the Roman Missal, named by its roman numeral.
+type Prayer string
+
+// ParsePrayer returns the Eucharistic Prayer with the given number, written either in roman or in arabic numerals.
+func ParsePrayer(s string) (Prayer, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "I", "1":
+		return "I", nil
+	case "II", "2":
+		return "II", nil
+	case "III", "3":
+		return "III", nil
+	case "IV", "4":
+		return "IV", nil
+	default:
+		return "", fmt.Errorf("eucharistic prayer %q: %w", s, gabcErrors.ErrUnknownPrayer)
+	}
+}
+
+type Part string
+
+const (
+	Epiclesis   Part = "epiclesis"   // the invocation of the Holy Spirit upon the gifts
+	Institution Part = "institution" // the institution narrative, with the words of the Lord between quotes
+	Doxology    Part = "doxology"    // "Por Cristo, com Cristo, e em Cristo", answered by the people's Amém
+)
+
+// ParsePart returns the part of the Eucharistic Prayer with the given name.
+func ParsePart(s string) (Part, error) {
+	switch p := Part(strings.ToLower(strings.TrimSpace(s))); p {
+	case Epiclesis, Institution, Doxology:
+		return p, nil
+	default:
+		return "", fmt.Errorf("eucharistic prayer part %q: %w", s, gabcErrors.ErrUnknownPart)
+	}
+}
+
+// DoxologyText is the final doxology, the same in every Eucharistic Prayer, in each language, with one line for each phrase.
+var DoxologyText = map[string]string{
+	"pt": "Por Cristo, com Cristo, e em Cristo,\na vós, Deus Pai todo-poderoso,\nna unidade do Espírito Santo,\ntoda a honra e toda a glória,\npor todos os séculos dos séculos.",
+	"la": "Per ipsum, et cum ipso, et in ipso,\nest tibi Deo Patri omnipoténti,\nin unitáte Spíritus Sancti,\nomnis honor et glória\nper ómnia sǽcula sæculórum.",
+}
+
+// EpiclesisText is the epiclesis of each Eucharistic Prayer, which is proper to it, in the languages whose Missal text is kept here.
+var EpiclesisText = map[Prayer]map[string]string{
+	"I": {
+		"la": "Quam oblatiónem tu, Deus, in ómnibus, quǽsumus,\nbenedíctam, adscríptam, ratam,\nrationábilem, acceptabilémque fácere dignéris:\nut nobis Corpus et Sanguis fiat dilectíssimi Fílii tui,\nDómini nostri Iesu Christi.",
+	},
+	"II": {
+		"la": "Hæc ergo dona, quǽsumus,\nSpíritus tui rore sanctífica,\nut nobis Corpus et Sanguis fiant\nDómini nostri Iesu Christi.",
+	},
+	"III": {
+		"la": "Supplíciter ergo te, Dómine, deprecámur,\nut hæc múnera, quæ tibi sacránda detúlimus,\neódem Spíritu sanctificáre dignéris,\nut Corpus et Sanguis fiant Fílii tui Dómini nostri Iesu Christi,\ncuius mandáto hæc mystéria celebrámus.",
+	},
+	"IV": {
+		"la": "Quǽsumus igitur, Dómine,\nut idem Spíritus Sanctus\nhæc múnera sanctificáre dignétur,\nut Corpus et Sanguis fiant Dómini nostri Iesu Christi\nad hoc magnum mystérium celebrándum,\nquod ipse nobis relíquit in fœdus ætérnum.",
+	},
+}
+
+// MissalText returns the text of a part of an Eucharistic Prayer as it is in the Missal, for the parts whose text is kept here:
+// the doxology of every prayer, and the epiclesis proper to each one of them.
+func MissalText(prayer Prayer, part Part, language string) (string, error) {
+	if _, ok := DoxologyText[language]; !ok {
+		return "", fmt.Errorf("missal text in %q: %w", language, gabcErrors.ErrUnknownLanguage)
+	}
+
+	var text string
+	switch part {
+	case Doxology:
+		text = DoxologyText[language]
+	case Epiclesis:
+		text = EpiclesisText[prayer][language]
+	}
+
+	if text == "" {
+		return "", fmt.Errorf("missal text of the %s of prayer %s in %q: %w", part, prayer, language, gabcErrors.ErrNoText)
+	}
+
+	return text, nil
+}
+
+// formulas are the reciting note and the cadences of a reciting tone.
+type formulas struct {
+	recite  string
+	flex    cadence.Cadence // at a comma
+	mediant cadence.Cadence // at a colon or a semicolon
+	final   cadence.Cadence // at the end of a sentence
+}
+
+// The priest recites the prayer on the higher note, and the words of the Lord a tone below it, so they stand out of the narrative.
+var (
+	prayerTone = formulas{
+		recite:  staff.Do,
+		flex:    cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoLa, After: staff.La},
+		mediant: cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.Si},
+		final:   cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La},
+	}
+	lordTone = formulas{
+		recite:  staff.Si,
+		flex:    cadence.Cadence{Tonic: staff.Si, Oxytone: staff.SiLa, After: staff.La},
+		mediant: cadence.Cadence{Tonic: staff.Si, Oxytone: staff.SiLa, After: staff.La},
+		final:   cadence.Cadence{Before: []string{staff.La}, Tonic: staff.Sol, Oxytone: staff.LaSol, After: staff.Sol},
+	}
+	doxologyTone = formulas{
+		recite:  staff.Do,
+		flex:    cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.Do},
+		mediant: cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.Do},
+		final:   cadence.Cadence{Before: []string{staff.Si, staff.Do}, Tonic: staff.La, Oxytone: staff.LaSiLa, After: staff.La},
+	}
+)
+
+type EucharisticPrayer struct {
+	Prayer       Prayer
+	Part         Part
+	Language     string           // language of the Amém, phrases.DefaultLanguage if empty
+	LinedText    string           // one line for each phrase
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the prayer melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	breaks       map[int]bool     // indexes of the phrases that end a paragraph
+}
+
+type ( // Phrase types that can occur in a part of an Eucharistic Prayer, chosen by the punctuation at their end
+	flex struct { // flex = reciting tone, falling after the last accent, at a comma;
+		phrases.Phrase
+		formulas formulas
+	}
+	mediant struct { // mediant = reciting tone and a half cadence, at a colon or a semicolon;
+		phrases.Phrase
+		formulas formulas
+	}
+	final struct { // final = reciting tone and final cadence, at the end of a sentence.
+		phrases.Phrase
+		formulas formulas
+	}
+)
+
+// New creates a new Eucharistic Prayer struct with the prayer, the part of it and its lined text.
+func New(prayer Prayer, part Part, language, linedText string) *EucharisticPrayer { // returning a pointer because this struct is going to be modified by its methods
+	return &EucharisticPrayer{
+		Prayer:    prayer,
+		Part:      part,
+		Language:  language,
+		LinedText: linedText,
+		breaks:    make(map[int]bool),
+	}
+}
+
+// TypePhrases types the already built phrases based on the punctuation at their end, on the tone of the part.
+// In the institution narrative, the phrases between quotes are the words of the Lord, sung on their own tone.
+func (ep *EucharisticPrayer) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	tone := prayerTone
+	if ep.Part == Doxology {
+		tone = doxologyTone
+	}
+
+	quoted := false // whether the words of the Lord are being quoted
+	for _, p := range newParagraphs {
+		for i, ph := range p.Phrases {
+			f := tone
+
+			var lord bool
+			if lord, quoted = quotes(ph.Text, quoted); lord && ep.Part == Institution {
+				f = lordTone
+			}
+
+			switch mark := phrases.LastMark(ph.Text); {
+			case i == len(p.Phrases)-1, mark == '.', mark == '!', mark == '?':
+				ep.Phrases = append(ep.Phrases, final{Phrase: *ph, formulas: f})
+			case mark == ':', mark == ';':
+				ep.Phrases = append(ep.Phrases, mediant{Phrase: *ph, formulas: f})
+			default:
+				ep.Phrases = append(ep.Phrases, flex{Phrase: *ph, formulas: f})
+			}
+		}
+
+		ep.breaks[len(ep.Phrases)-1] = true
+	}
+
+	return nil
+}
+
+// quotes tells whether a phrase quotes someone, either because it opens a quote or because it goes on with one,
+// and whether the quote is still open at its end.
+func quotes(text string, open bool) (quoting, stillOpen bool) {
+	quoting = open
+	for _, r := range text {
+		switch r {
+		case '“':
+			open, quoting = true, true
+		case '”':
+			open = false
+		case '"':
+			open = !open
+			quoting = true
+		}
+	}
+
+	return quoting, open
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase of the part and returns the composed GABC string.
+// The final doxology is followed by the Amém of the people.
+func (ep *EucharisticPrayer) ApplyGabcMelodies() error {
+	var composedGABC string
+
+	for i, ph := range ep.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+
+		if ep.breaks[i] {
+			composedGABC = strings.TrimSuffix(composedGABC, "\n") + "(Z)\n\n" // gabc code for a new line of score at the end of each paragraph
+		}
+	}
+
+	// Adjust the ending of the composed GABC string
+	composedGABC = strings.TrimSuffix(composedGABC, "(:)(Z)\n\n") + "(::)"
+
+	if ep.Part == Doxology {
+		language := ep.Language
+		if language == "" {
+			language = phrases.DefaultLanguage
+		}
+
+		amen, ok := staff.SolemnAmen[language]
+		if !ok {
+			return fmt.Errorf("amen in %q: %w", language, gabcErrors.ErrUnknownLanguage)
+		}
+
+		composedGABC = composedGABC + " " + amen
+	}
+
+	ep.ComposedGABC = composedGABC
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph flex) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.flex); err != nil {
+		return "", fmt.Errorf("flex phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(,)\n" // gabc code for the "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph mediant) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.mediant); err != nil {
+		return "", fmt.Errorf("mediant phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(;)\n" // gabc code for the "half bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph final) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.final); err != nil {
+		return "", fmt.Errorf("final phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
@@ -0,0 +1,94 @@
+package eucharistic_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/latinsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+	"github.com/ramon-reichert/gabcgen/internal/service/eucharistic"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGenerateEucharistic(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("sings the words of the Lord on their own tone", func(t *testing.T) {
+		is := is.New(t)
+
+		inputText := "Na verdade, é digno:\n“Cristo, nosso Senhor,\né justo.”\nPor isso, é digno."
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateEucharistic(ctx, "II", "institution", inputText)
+		is.NoErr(err)
+
+		expectedGABC := "Na(h) ver(h)da(h)de,(h) é(h) dig(g)no:(g) (;)\n" +
+			"\"Cris(g)to,(g) nos(g)so(g) Se(g)nhor,(gf) (,)\né(f) jus(e)to.\"(e) (:)\n" + // a tone below, between the quotes
+			"Por(h) is(h)so,(h) é(g) dig(f)no.(f) (::)"
+
+		is.Equal(composedGABC, expectedGABC)
+	})
+
+	t.Run("the doxology is answered by the Amém", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateEucharistic(ctx, "IV", "doxology", "Por Cristo, nosso Senhor,\nna verdade, é justo.")
+		is.NoErr(err)
+
+		is.Equal(composedGABC, "Por(h) Cris(h)to,(h) nos(h)so(h) Se(h)nhor,(hg) (,)\nna(h) ver(h)da(h)de,(h) é(g) jus(f)to.(f) (::) "+staff.SolemnAmen["pt"])
+	})
+
+	t.Run("the Latin doxology is syllabified by the rules of Latin", func(t *testing.T) {
+		is := is.New(t)
+
+		gen := service.NewGabcGenAPI(syllabifier) // the mock knows no Latin word
+		gen.Languages = map[string]words.Syllabifier{"la": latinsyllabifier.NewSyllabifier()}
+
+		score, err := gen.ComposeEucharistic(ctx, "I", "doxology", eucharistic.DoxologyText["la"], service.Options{Language: "la"})
+		is.NoErr(err)
+
+		is.Equal(score.GABC, "Per(h) ip(h)sum,(h) et(h) cum(h) ip(h)so,(h) et(h) in(h) ip(g)so,(h) (,)\n"+
+			"est(h) ti(h)bi(h) De(h)o(h) Pa(h)tri(h) om(h)ni(h)po(h)tén(g)ti,(h) (,)\n"+
+			"in(h) u(h)ni(h)tá(h)te(h) Spí(h)ri(h)tus(h) Sanc(g)ti,(h) (,)\n"+
+			"om(h)nis(h) ho(h)nor(h) et(h) gló(g)ri(h)a(h) (,)\n"+
+			"per(h) óm(h)ni(h)a(h) sǽ(h)cu(h)la(h) sæ(h)cu(g)ló(f)rum.(f) (::) "+staff.SolemnAmen["la"])
+	})
+
+	t.Run("sings the Latin epiclesis of the prayer when no text is given", func(t *testing.T) {
+		is := is.New(t)
+
+		gen := service.NewGabcGenAPI(syllabifier)
+		gen.Languages = map[string]words.Syllabifier{"la": latinsyllabifier.NewSyllabifier()}
+
+		score, err := gen.ComposeEucharistic(ctx, "2", "epiclesis", "", service.Options{Language: "la"})
+		is.NoErr(err)
+
+		is.Equal(score.GABC, "Hæc(h) er(h)go(h) do(h)na,(h) quǽ(h)su(f)mus,(f) (,)\n"+
+			"Spí(h)ri(h)tus(h) tu(h)i(h) ro(h)re(h) sanc(h)tí(h)fi(f)ca,(f) (,)\n"+
+			"ut(h) no(h)bis(h) Cor(h)pus(h) et(h) San(h)guis(h) fi(h)ant(f) (,)\n"+
+			"Dó(h)mi(h)ni(h) nos(h)tri(h) Ie(h)su(g) Chris(f)ti.(f) (::)")
+	})
+
+	t.Run("requires the text when the Missal one is not kept", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateEucharistic(ctx, "II", "epiclesis", "")
+		is.True(errors.Is(err, gabcErrors.ErrNoText))
+	})
+
+	t.Run("rejects unknown prayers and parts", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateEucharistic(ctx, "V", "doxology", "")
+		is.True(errors.Is(err, gabcErrors.ErrUnknownPrayer))
+
+		_, err = service.NewGabcGenAPI(syllabifier).GenerateEucharistic(ctx, "I", "anamnesis", "Na verdade.")
+		is.True(errors.Is(err, gabcErrors.ErrUnknownPart))
+	})
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/exsultet"
+)
+
+// GenerateExsultet attaches GABC code to each syllable of the Easter Proclamation: the paragraphs of its introduction,
+// sung before the dialogue, and the ones of its praise, after it. The minister, "deacon", "presbyter" or "cantor", chooses the variant of the introduction.
+func (gen GabcGen) GenerateExsultet(ctx context.Context, minister, introduction, text string) (string, error) {
+	score, err := gen.ComposeExsultet(ctx, minister, introduction, text, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeExsultet works like GenerateExsultet, taking the per-text options and returning the warnings along with the GABC.
+func (gen GabcGen) ComposeExsultet(ctx context.Context, minister, introduction, text string, opts Options) (Score, error) {
+	m, err := exsultet.ParseMinister(minister)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Exsultet: %w", err)
+	}
+
+	ex := exsultet.New(m, introduction, text)
+
+	newParagraphs, _, score, err := gen.buildParagraphs(ctx, ex.LinedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Exsultet: %w", err)
+	}
+
+	if err := ex.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Exsultet: %w", err)
+	}
+
+	if err := ex.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Exsultet: %w", err)
+	}
+
+	score.GABC = ex.ComposedGABC
+
+	return score, nil
+}
@@ -0,0 +1,220 @@
+// Package exsultet handles specific phrase types that compose the melody of the Exsultet, the Easter Proclamation.
+package exsultet
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+	"github.com/ramon-reichert/gabcgen/internal/service/preface"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+// Minister is who sings the Exsultet, which chooses the variant of its introduction.
+type Minister string
+
+const (
+	Deacon    Minister = "deacon"    // sings the whole introduction, counted "entre os levitas"
+	Presbyter Minister = "presbyter" // sings the whole introduction, counted "entre os sacerdotes"
+	Cantor    Minister = "cantor"    // a lay cantor leaves out the invitation that closes the introduction and the greeting of the dialogue
+)
+
+// ParseMinister returns the minister with the given name. The deacon, who ordinarily sings the Exsultet, is the default.
+func ParseMinister(s string) (Minister, error) {
+	switch m := Minister(strings.ToLower(strings.TrimSpace(s))); m {
+	case "":
+		return Deacon, nil
+	case Deacon, Presbyter, Cantor:
+		return m, nil
+	default:
+		return "", fmt.Errorf("exsultet minister %q: %w", s, gabcErrors.ErrUnknownMinister)
+	}
+}
+
+// variants are the words of the introduction that change with the minister, keyed by the words of the deacon.
+// They are only replaced in the introduction.
+var variants = map[Minister]map[string]string{
+	Presbyter: {
+		"levitas": "sacerdotes",
+	},
+}
+
+// nightRefrains are the beginnings of the phrases that proclaim the holy night, sung with their own formula, like "Esta é a noite".
+var nightRefrains = []string{"Eis a noite", "Esta é a noite", "Ó noite", "Ó verdadeiramente", "Haec nox est", "O vere beata nox", "O beata nox"}
+
+type Exsultet struct {
+	Minister     Minister
+	LinedText    string           // the introduction paragraphs followed by the paragraphs after the dialogue
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the Exsultet melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	introduction int              // number of paragraphs of the introduction, sung before the dialogue
+	breaks       map[int]bool     // indexes of the phrases that end the introduction
+}
+
+type ( // Phrase types that the Exsultet adds to the ones of the Preface
+	intonation struct { // intonation = solemn rising intonation, reciting tone, short cadence, at the very beginning;
+		phrases.Phrase
+	}
+	night struct { // night = reciting tone and a falling cadence, at the proclamation of the holy night, like "Esta é a noite".
+		phrases.Phrase
+	}
+)
+
+// New creates a new Exsultet struct with the minister, the paragraphs of the introduction, sung before the dialogue, and the paragraphs after it.
+// The invitation, the last paragraph of the introduction, is left out when a cantor sings it.
+func New(minister Minister, introduction, text string) *Exsultet { // returning a pointer because this struct is going to be modified by its methods
+	intro := paragraphs(introduction)
+	if minister == Cantor && len(intro) > 1 {
+		intro = intro[:len(intro)-1]
+	}
+
+	for i := range intro { // the praise after the dialogue may speak of the levites of the Old Testament
+		for deacon, other := range variants[minister] {
+			intro[i] = strings.ReplaceAll(intro[i], deacon, other)
+			intro[i] = strings.ReplaceAll(intro[i], capitalize(deacon), capitalize(other))
+		}
+	}
+
+	linedText := strings.Join(append(intro, paragraphs(text)...), "\n\n")
+
+	return &Exsultet{
+		Minister:     minister,
+		LinedText:    linedText,
+		introduction: len(intro),
+		breaks:       make(map[int]bool),
+	}
+}
+
+// capitalize turns the first letter of a word into upper case.
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+// paragraphs splits a lined text into its paragraphs, dropping the empty ones.
+func paragraphs(linedText string) []string {
+	var p []string
+	for _, s := range strings.Split(strings.ReplaceAll(linedText, "\r\n", "\n"), "\n\n") {
+		if s = strings.TrimSpace(s); s != "" {
+			p = append(p, s)
+		}
+	}
+
+	return p
+}
+
+// TypePhrases types the already built phrases of each paragraph the way the Preface does, after taking out the phrases
+// that have their own melody in the Exsultet: the intonation of the first paragraph and the proclamations of the holy night that open a paragraph.
+func (ex *Exsultet) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	if ex.introduction == 0 || len(newParagraphs) <= ex.introduction {
+		return fmt.Errorf("typing phrases: %w", gabcErrors.ErrShortExsultet)
+	}
+
+	for n, p := range newParagraphs {
+		switch {
+		case n == 0:
+			ex.Phrases = append(ex.Phrases, intonation{Phrase: *p.Phrases[0]})
+			p.Phrases = p.Phrases[1:]
+		case isNight(p.Phrases[0].Text):
+			ex.Phrases = append(ex.Phrases, night{Phrase: *p.Phrases[0]})
+			p.Phrases = p.Phrases[1:]
+		}
+
+		if len(p.Phrases) == 0 {
+			return fmt.Errorf("typing phrase: paragraph %v: %w", n+1, gabcErrors.ErrShortParagraph)
+		}
+
+		text := preface.New("")
+		if err := text.TypePhrases([]phrases.Paragraph{p}); err != nil {
+			return fmt.Errorf("typing phrases: paragraph %v: %w", n+1, err)
+		}
+
+		for _, ph := range text.Phrases {
+			ex.Phrases = append(ex.Phrases, ph)
+		}
+
+		if n == ex.introduction-1 {
+			ex.breaks[len(ex.Phrases)-1] = true
+		}
+	}
+
+	return nil
+}
+
+// isNight tells whether a phrase proclaims the holy night.
+func isNight(text string) bool {
+	for _, r := range nightRefrains {
+		if strings.HasPrefix(text, r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the Exsultet and returns the composed GABC string.
+// The introduction closes with a double bar, and the dialogue of the Preface comes after it, without its greeting when a cantor sings.
+func (ex *Exsultet) ApplyGabcMelodies() error {
+	composedGABC := "<c><sp>V/</sp></c> "
+
+	for i, ph := range ex.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+
+		if ex.breaks[i] {
+			composedGABC = strings.TrimSuffix(composedGABC, "(:)(Z)\n\n") + "(::)(Z)\n\n" + ex.dialogue() + "\n\n<c><sp>V/</sp></c> "
+		}
+	}
+
+	// Adjust the ending of the composed GABC string
+	ex.ComposedGABC = strings.TrimSuffix(composedGABC, "(:)(Z)\n\n") + "(::)"
+
+	return nil
+}
+
+// dialogue returns the dialogue of the solemn Preface, which the Exsultet sings between its introduction and its praise.
+func (ex *Exsultet) dialogue() string {
+	d := string(preface.Solemn)
+	if ex.Minister == Cantor {
+		_, d, _ = strings.Cut(d, "(Z) ") // leaving out "O Senhor esteja convosco" and its answer
+	}
+
+	return d
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph intonation) ApplyMelody() (string, error) {
+	c := cadence.Cadence{Before: []string{staff.SiLa}, Tonic: staff.LaSi, Oxytone: staff.LaSi, After: staff.Si}
+	if err := cadence.Sing(ph.Syllables, []string{staff.Sol, staff.La}, staff.Do, c); err != nil {
+		return "", fmt.Errorf("intonation phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(;)\n" // gabc code for the "half bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph night) ApplyMelody() (string, error) {
+	c := cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoSi, After: staff.Si}
+	if err := cadence.Sing(ph.Syllables, []string{staff.La}, staff.Do, c); err != nil {
+		return "", fmt.Errorf("night phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(,)\n" // gabc code for the "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
@@ -0,0 +1,75 @@
+package exsultet_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/preface"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGenerateExsultet(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	introduction := "Na verdade, é digno e justo,\nNa verdade,\né digno\nna verdade, é justo.\n\n" +
+		"Por isso, na verdade,\nNa verdade, digno,\nnosso Senhor\nna verdade, é justo." // the invitation
+	text := "Esta é a noite,\nNa verdade, digno,\nnosso Senhor\nCristo, nosso Senhor."
+
+	t.Run("a cantor leaves out the invitation and the greeting", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateExsultet(ctx, "cantor", introduction, text)
+		is.NoErr(err)
+
+		_, dialogue, _ := strings.Cut(string(preface.Solemn), "(Z) ")
+
+		expectedGABC := "<c><sp>V/</sp></c> Na(e) ver(f)da(h)de,(h) é(h) dig(h)no(h) e(gf) jus(fg)to,(g) (;)\n" + // the solemn intonation
+			"Na(f) ver(gf)da(fg)de,(g) (;)\né(g) dig(fgh)no(g) (,)\nna(g) ver(g)da(fe)de,(ef) é(g) jus(fg)to.(f) (::)(Z)\n\n" +
+			dialogue + "\n\n" +
+			"<c><sp>V/</sp></c> Es(f)ta(h) é(h) a(h) noi(h)te,(g) (,)\n" + // a proclamation of the night
+			"Na(f) ver(h)da(h)de,(gf) dig(fg)no,(g) (;)\nnos(g)so(f) Se(g)nhor(h) (,)\nCris(g)to,(g) nos(fe)so(ef) Se(g)nhor.(fgf) (::)"
+
+		is.Equal(composedGABC, expectedGABC)
+	})
+
+	t.Run("the deacon sings the whole introduction and dialogue", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateExsultet(ctx, "deacon", introduction, text)
+		is.NoErr(err)
+
+		is.True(strings.Contains(composedGABC, "Por(f) is(f)so,(f) na(f) ver(f)da(ef)de,(f) (,)\n")) // the invitation
+		is.True(strings.Contains(composedGABC, string(preface.Solemn)))
+	})
+
+	t.Run("a presbyter is counted among the priests only in the introduction", func(t *testing.T) {
+		is := is.New(t)
+
+		introduction := "Na verdade, é digno e justo,\nNa verdade, levitas,\né digno\nna verdade, é justo.\n\n" + "Levitas, na verdade,\nNa verdade, digno,\nnosso Senhor\nna verdade, é justo."
+		text := "Esta é a noite,\nNa verdade, levitas,\nnosso Senhor\nCristo, nosso Senhor."
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateExsultet(ctx, "presbyter", introduction, text)
+		is.NoErr(err)
+
+		intro, praise, _ := strings.Cut(regexp.MustCompile(`\([^)]*\)`).ReplaceAllString(composedGABC, ""), "Esta é a noite,")
+		is.True(strings.Contains(intro, "Na verdade, sacerdotes,"))
+		is.True(strings.Contains(intro, "Sacerdotes, na verdade,"))
+		is.True(!strings.Contains(intro, "levitas"))
+		is.True(strings.Contains(praise, "Na verdade, levitas,"))
+	})
+
+	t.Run("needs paragraphs after the dialogue", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateExsultet(ctx, "presbyter", introduction, "")
+		is.True(errors.Is(err, gabcErrors.ErrShortExsultet))
+	})
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/litany"
+)
+
+// GenerateLitany attaches GABC code to each syllable of the invocations of a litany, one in each line, answered by the given response,
+// like "rogai por nós". A line starting with "R." changes the response of the invocations after it.
+func (gen GabcGen) GenerateLitany(ctx context.Context, response, text string) (string, error) {
+	score, err := gen.ComposeLitany(ctx, response, text, nil, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeLitany works like GenerateLitany, taking the saints added to the litany and the per-text options, and returning the warnings along with the GABC.
+func (gen GabcGen) ComposeLitany(ctx context.Context, response, text string, saints []string, opts Options) (Score, error) {
+	l := litany.New(response, text, saints)
+
+	newParagraphs, _, score, err := gen.buildParagraphs(ctx, l.LinedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Litany: %w", err)
+	}
+
+	if err := l.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Litany: %w", err)
+	}
+
+	if err := l.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Litany: %w", err)
+	}
+
+	score.GABC = l.ComposedGABC
+
+	return score, nil
+}
@@ -0,0 +1,206 @@
+// Package litany handles specific phrase types that compose the melody of litanies, like the Litany of the Saints.
+package litany
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+// ResponseMarker starts a line of the text that changes the response of the invocations after it, like "R. ouvi-nos, Senhor".
+const ResponseMarker = "R."
+
+// allSaints are the beginnings of the invocation that closes the saints of a litany, in each language.
+// The saints added by the user are invoked right before it.
+var allSaints = []string{"todos os santos", "todas as santas", "omnes sancti"}
+
+// The cantor sings the invocations on the higher note, and the people answer a tone below.
+var (
+	invocationCadence = cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.Si}
+	responseCadence   = cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.Si, Oxytone: staff.SiLa, After: staff.La}
+)
+
+type Litany struct {
+	LinedText    string           // a paragraph for each group of invocations, with its response in the first line
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the litany melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	unanswered   bool             // whether there are invocations before any response was given
+	uninvoked    bool             // whether a response of the text is not followed by any invocation
+}
+
+type ( // Phrase types that can occur in a Litany
+	invocation struct { // invocation = reciting tone and cadence on the last accent, sung by the cantor;
+		phrases.Phrase
+	}
+	response struct { // response = intonation, reciting tone and cadence on the last accent, answered by the people after each invocation of its group.
+		phrases.Phrase
+	}
+)
+
+// New creates a new litany struct with the response of the first invocations and the text, with one invocation in each line.
+// A line starting with the ResponseMarker changes the response of the invocations after it. The added saints are invoked
+// right before the invocation of all the saints, or at the end of the litany if there is none.
+func New(firstResponse, text string, saints []string) *Litany { // returning a pointer because this struct is going to be modified by its methods
+	litany := &Litany{}
+
+	type group struct {
+		response    string
+		invocations []string
+	}
+
+	groups := []group{{response: strings.TrimSpace(firstResponse)}}
+
+	for line := range strings.Lines(strings.ReplaceAll(text, "\r\n", "\n")) {
+		line = strings.TrimSpace(line)
+
+		if r, ok := strings.CutPrefix(line, ResponseMarker); ok {
+			groups = append(groups, group{response: strings.TrimSpace(r)})
+			continue
+		}
+
+		if line != "" {
+			g := &groups[len(groups)-1]
+			g.invocations = append(g.invocations, line)
+		}
+	}
+
+	// Add the saints
+	if len(saints) > 0 {
+		var added []string
+		for _, s := range saints {
+			if s = strings.TrimSpace(s); s != "" {
+				added = append(added, strings.TrimRight(s, ",")+",")
+			}
+		}
+
+		gi, ii := len(groups)-1, len(groups[len(groups)-1].invocations)
+	search:
+		for g := range groups {
+			for i, inv := range groups[g].invocations {
+				if isAllSaints(inv) {
+					gi, ii = g, i
+					break search
+				}
+			}
+		}
+
+		g := &groups[gi]
+		g.invocations = append(g.invocations[:ii], append(added, g.invocations[ii:]...)...)
+	}
+
+	var paragraphs []string
+	for i, g := range groups {
+		if len(g.invocations) == 0 {
+			litany.uninvoked = litany.uninvoked || i > 0 // the first response may be changed before any invocation
+			continue
+		}
+
+		if g.response == "" { // kept in the text so its words are still checked, but refused when typing
+			litany.unanswered = true
+			paragraphs = append(paragraphs, strings.Join(g.invocations, "\n"))
+			continue
+		}
+
+		paragraphs = append(paragraphs, g.response+"\n"+strings.Join(g.invocations, "\n"))
+	}
+
+	litany.LinedText = strings.Join(paragraphs, "\n\n")
+
+	return litany
+}
+
+// isAllSaints tells whether an invocation calls upon all the saints.
+func isAllSaints(invocation string) bool {
+	for _, s := range allSaints {
+		if strings.HasPrefix(strings.ToLower(invocation), s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TypePhrases types the already built phrases: the first phrase of each paragraph is the response of the invocations that follow it.
+func (litany *Litany) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	if litany.unanswered {
+		return fmt.Errorf("typing phrases: %w", gabcErrors.ErrNoResponse)
+	}
+
+	if litany.uninvoked {
+		return fmt.Errorf("typing phrases: %w", gabcErrors.ErrNoInvocation)
+	}
+
+	for _, p := range newParagraphs {
+		if len(p.Phrases) < 2 {
+			return fmt.Errorf("typing phrase: %v - %w", p.Phrases[0].Text, gabcErrors.ErrNoInvocation)
+		}
+
+		litany.Phrases = append(litany.Phrases, response{Phrase: *p.Phrases[0]})
+
+		for _, ph := range p.Phrases[1:] {
+			litany.Phrases = append(litany.Phrases, invocation{Phrase: *ph})
+		}
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the litany and returns the composed GABC string,
+// with the response of its group answering each invocation.
+func (litany *Litany) ApplyGabcMelodies() error {
+	var composedGABC string
+	var answer string // GABC of the response of the current group
+
+	for _, ph := range litany.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		if _, ok := ph.(response); ok {
+			if answer != "" {
+				composedGABC = strings.TrimSuffix(composedGABC, "\n") + "(Z)\n" // gabc code for a new line of score when the response changes
+			}
+
+			answer = gabcPhrase
+			continue
+		}
+
+		composedGABC = composedGABC + gabcPhrase + answer
+	}
+
+	// Adjust the ending of the composed GABC string
+	litany.ComposedGABC = strings.TrimSuffix(composedGABC, "\n")
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph invocation) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, staff.Do, invocationCadence); err != nil {
+		return "", fmt.Errorf("invocation phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(;) <c><sp>R/</sp></c> " // gabc code for the "half bar", followed by the answer of the people
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph response) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, []string{staff.La}, staff.Si, responseCadence); err != nil {
+		return "", fmt.Errorf("response phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(::)\n" // gabc code for the "double bar", to be added at the end of each answer
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
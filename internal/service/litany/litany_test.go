@@ -0,0 +1,48 @@
+package litany_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGenerateLitany(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("answers each invocation with the response of its group, invoking the added saints", func(t *testing.T) {
+		is := is.New(t)
+
+		inputText := "Cristo, nosso Senhor,\nTodos os santos,\nR. Senhor\nNa verdade,"
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposeLitany(ctx, "é digno", inputText, []string{"Cristo"}, service.Options{})
+		is.NoErr(err)
+
+		expectedGABC := "Cris(h)to,(h) nos(h)so(h) Se(h)nhor,(hg) (;) <c><sp>R/</sp></c> é(g) dig(g)no(f) (::)\n" +
+			"Cris(g)to,(g) (;) <c><sp>R/</sp></c> é(g) dig(g)no(f) (::)\n" + // the added saint, before the invocation of all the saints
+			"To(h)dos(h) os(h) san(g)tos,(g) (;) <c><sp>R/</sp></c> é(g) dig(g)no(f) (::)(Z)\n" +
+			"Na(h) ver(h)da(g)de,(g) (;) <c><sp>R/</sp></c> Se(g)nhor(gf) (::)"
+
+		is.Equal(score.GABC, expectedGABC)
+	})
+
+	t.Run("needs a response for the first invocations", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateLitany(ctx, "", "Cristo, nosso Senhor,")
+		is.True(errors.Is(err, gabcErrors.ErrNoResponse))
+	})
+
+	t.Run("needs an invocation after each response", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateLitany(ctx, "é digno", "Cristo, nosso Senhor,\nR. Senhor")
+		is.True(errors.Is(err, gabcErrors.ErrNoInvocation))
+	})
+}
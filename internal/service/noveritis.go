@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/noveritis"
+)
+
+// GenerateNoveritis attaches GABC code to the announcement of the date of Easter and of the movable feasts of the given year,
+// computed and written out as words in the announcement of the Missal, in Portuguese.
+func (gen GabcGen) GenerateNoveritis(ctx context.Context, year int) (string, error) {
+	score, err := gen.ComposeNoveritis(ctx, year, false, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeNoveritis works like GenerateNoveritis, in the language of the options, "pt" or "la", returning the warnings along with the GABC.
+// The Ascension is announced on the Sunday after its Thursday when ascensionOnSunday is true, as it is transferred in Brazil.
+func (gen GabcGen) ComposeNoveritis(ctx context.Context, year int, ascensionOnSunday bool, opts Options) (Score, error) {
+	dates, err := noveritis.Computus(year, ascensionOnSunday)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Noveritis: %w", err)
+	}
+
+	language := opts.Language
+	if language == "" {
+		language = phrases.DefaultLanguage
+	}
+
+	text, err := noveritis.Text(dates, language)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Noveritis: %w", err)
+	}
+
+	newParagraphs, linedText, score, err := gen.buildParagraphs(ctx, text, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Noveritis: %w", err)
+	}
+
+	announcement := noveritis.New(language, linedText)
+
+	if err := announcement.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Noveritis: %w", err)
+	}
+
+	if err := announcement.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Noveritis: %w", err)
+	}
+
+	score.GABC = announcement.ComposedGABC
+
+	return score, nil
+}
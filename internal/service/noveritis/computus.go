@@ -0,0 +1,67 @@
+// Package noveritis handles the announcement of the date of Easter and of the movable feasts, sung on the Epiphany.
+package noveritis
+
+import (
+	"fmt"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+)
+
+// FirstYear is the first year of the Gregorian calendar whose Easter can be computed.
+const FirstYear = 1583
+
+// Dates are the movable feasts of a year, all depending on the date of Easter but the First Sunday of Advent.
+type Dates struct {
+	AshWednesday time.Time
+	Easter       time.Time
+	Ascension    time.Time // on Thursday, or on the following Sunday where it is transferred, like in Brazil
+	Pentecost    time.Time
+	Advent       time.Time // the First Sunday of Advent, opening the next liturgical year
+}
+
+// Computus computes the dates of the movable feasts of a Gregorian year.
+func Computus(year int, ascensionOnSunday bool) (Dates, error) {
+	if year < FirstYear {
+		return Dates{}, fmt.Errorf("computus of %v: %w", year, gabcErrors.ErrInvalidYear)
+	}
+
+	easter := Easter(year)
+
+	ascension := easter.AddDate(0, 0, 39)
+	if ascensionOnSunday {
+		ascension = easter.AddDate(0, 0, 42)
+	}
+
+	// The First Sunday of Advent is the fourth Sunday before Christmas
+	christmas := time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC)
+	back := int(christmas.Weekday())
+	if back == 0 {
+		back = 7
+	}
+
+	return Dates{
+		AshWednesday: easter.AddDate(0, 0, -46),
+		Easter:       easter,
+		Ascension:    ascension,
+		Pentecost:    easter.AddDate(0, 0, 49),
+		Advent:       christmas.AddDate(0, 0, -back-21),
+	}, nil
+}
+
+// Easter returns the date of Easter of a Gregorian year, by the anonymous algorithm published by Meeus.
+func Easter(year int) time.Time {
+	a := year % 19 // place of the year in the Metonic cycle
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30 // epact, giving the paschal full moon
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7 // days from the full moon to the next Sunday
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
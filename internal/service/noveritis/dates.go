@@ -0,0 +1,36 @@
+// Package noveritis handles the announcement of the date of Easter and of the movable feasts, sung on the Epiphany.
+package noveritis
+
+import (
+	"fmt"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+)
+
+var (
+	ptDays = []string{"", "primeiro", "dois", "três", "quatro", "cinco", "seis", "sete", "oito", "nove", "dez",
+		"onze", "doze", "treze", "catorze", "quinze", "dezesseis", "dezessete", "dezoito", "dezenove", "vinte",
+		"vinte e um", "vinte e dois", "vinte e três", "vinte e quatro", "vinte e cinco", "vinte e seis", "vinte e sete", "vinte e oito", "vinte e nove", "trinta",
+		"trinta e um"}
+	ptMonths = []string{"", "janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}
+
+	// Latin days are ordinals agreeing with "die", as in "die quarta Aprílis", with the accent marks of their longer words
+	laDays = []string{"", "prima", "secúnda", "tértia", "quarta", "quinta", "sexta", "séptima", "octáva", "nona", "décima",
+		"undécima", "duodécima", "décima tértia", "décima quarta", "décima quinta", "décima sexta", "décima séptima", "duodevicésima", "undevicésima", "vicésima",
+		"vicésima prima", "vicésima secúnda", "vicésima tértia", "vicésima quarta", "vicésima quinta", "vicésima sexta", "vicésima séptima", "duodetricésima", "undetricésima", "tricésima",
+		"tricésima prima"}
+	laMonths = []string{"", "Ianuárii", "Februárii", "Mártii", "Aprílis", "Máii", "Iúnii", "Iúlii", "Augústi", "Septémbris", "Octóbris", "Novémbris", "Decémbris"} // in the genitive
+)
+
+// DateWords writes a date out as words, the way it is sung: "vinte de abril" in Portuguese, "vicésima Aprílis" in Latin.
+func DateWords(t time.Time, language string) (string, error) {
+	switch language {
+	case "pt":
+		return ptDays[t.Day()] + " de " + ptMonths[t.Month()], nil
+	case "la":
+		return laDays[t.Day()] + " " + laMonths[t.Month()], nil
+	default:
+		return "", fmt.Errorf("date in %q: %w", language, gabcErrors.ErrUnknownLanguage)
+	}
+}
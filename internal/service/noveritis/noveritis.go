@@ -0,0 +1,162 @@
+// Package noveritis handles the announcement of the date of Easter and of the movable feasts, sung on the Epiphany.
+package noveritis
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+// texts are the announcements of the Roman Missal in each language, with one line for each phrase and blank lines between paragraphs.
+// The Latin one keeps the accent marks of the Missal, which give the tonic syllable of its longer words.
+// The dates are filled in place of {ash}, {easter}, {ascension}, {pentecost} and {advent}; the Amen is answered by the people.
+var texts = map[string]string{
+	"pt": "Irmãos caríssimos,\na glória do Senhor se manifestou\ne sempre há de se manifestar no meio de nós,\naté a sua vinda no fim dos tempos.\n\n" +
+		"Nos ritmos e nas vicissitudes do tempo,\nrecordamos e vivemos os mistérios da salvação.\n\n" +
+		"O centro de todo o ano litúrgico\né o Tríduo do Senhor crucificado, sepultado e ressuscitado,\nque culminará no domingo da Páscoa,\neste ano celebrado no dia {easter}.\n\n" +
+		"Em cada domingo, Páscoa semanal,\na santa Igreja torna presente este acontecimento,\nno qual Cristo venceu o pecado e a morte.\n\n" +
+		"Da Páscoa derivam todos os dias santos:\nas Cinzas, início da Quaresma, no dia {ash};\na Ascensão do Senhor, no dia {ascension};\nPentecostes, no dia {pentecost};\no primeiro domingo do Advento, no dia {advent}.\n\n" +
+		"Também nas festas da santa Mãe de Deus, dos Apóstolos, dos Santos\ne na comemoração dos fiéis defuntos,\na Igreja peregrina sobre a terra\nproclama a Páscoa do seu Senhor.\n\n" +
+		"A Cristo, que era, que é e que há de vir,\nSenhor do tempo e da história,\nlouvor e glória pelos séculos dos séculos.",
+	"la": "Novéritis, fratres caríssimi,\nquod, annuénte Dei misericórdia,\nsicut de Nativitáte Dómini nostri Iesu Christi gavísi sumus,\nita et de Resurrectióne eiúsdem Salvatóris nostri\ngáudium vobis annuntiámus.\n\n" +
+		"Die {ash} erit dies Cínerum,\net inítium ieiúnii sacratíssimæ Quadragésimæ.\n\n" +
+		"Die {easter} sanctum Pascha Dómini nostri Iesu Christi cum gáudio celebrábimus.\n\n" +
+		"Die {ascension} erit Ascénsio Dómini nostri Iesu Christi.\n\n" +
+		"Die {pentecost} festum Pentecóstes.\n\n" +
+		"Die {advent} Domínica prima Advéntus Dómini nostri Iesu Christi,\ncui est honor et glória,\nin sǽcula sæculórum.",
+}
+
+// Text fills the announcement of the given language with the dates of the movable feasts, written out as words.
+func Text(dates Dates, language string) (string, error) {
+	text, ok := texts[language]
+	if !ok {
+		return "", fmt.Errorf("announcement in %q: %w", language, gabcErrors.ErrUnknownLanguage)
+	}
+
+	var pairs []string
+	for _, d := range []struct {
+		name string
+		date time.Time
+	}{{"{ash}", dates.AshWednesday}, {"{easter}", dates.Easter}, {"{ascension}", dates.Ascension}, {"{pentecost}", dates.Pentecost}, {"{advent}", dates.Advent}} {
+		w, err := DateWords(d.date, language)
+		if err != nil {
+			return "", err
+		}
+
+		pairs = append(pairs, d.name, w)
+	}
+
+	return strings.NewReplacer(pairs...).Replace(text), nil
+}
+
+// The announcement is recited on the higher note, rising back to it at each flex and falling a third at the end of each sentence.
+var (
+	recite          = staff.Do
+	flexCadence     = cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.Si}
+	fullStopCadence = cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La}
+)
+
+type Announcement struct {
+	Language     string           // language of the Amen
+	LinedText    string           // one line for each phrase, with blank lines between the paragraphs
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the announcement melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	breaks       map[int]bool     // indexes of the phrases that end a paragraph
+}
+
+type ( // Phrase types that can occur in the Announcement, chosen by the punctuation at their end
+	flex struct { // flex = reciting tone and a short fall after the last accent, inside a sentence;
+		phrases.Phrase
+	}
+	fullStop struct { // fullStop = reciting tone and final cadence, at the end of a sentence.
+		phrases.Phrase
+	}
+)
+
+// New creates a new announcement struct with the language and the lined text, already filled with the dates.
+func New(language, linedText string) *Announcement { // returning a pointer because this struct is going to be modified by its methods
+	return &Announcement{
+		Language:  language,
+		LinedText: linedText,
+		breaks:    make(map[int]bool),
+	}
+}
+
+// TypePhrases types the already built phrases based on the punctuation at their end.
+// The last phrase of each paragraph always closes it with the full stop cadence.
+func (a *Announcement) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	for _, p := range newParagraphs {
+		for i, ph := range p.Phrases {
+			if mark := phrases.LastMark(ph.Text); i == len(p.Phrases)-1 || mark == '.' || mark == '!' {
+				a.Phrases = append(a.Phrases, fullStop{Phrase: *ph})
+				continue
+			}
+
+			a.Phrases = append(a.Phrases, flex{Phrase: *ph})
+		}
+
+		a.breaks[len(a.Phrases)-1] = true
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the announcement and returns the composed GABC string,
+// answered by the Amen of the people.
+func (a *Announcement) ApplyGabcMelodies() error {
+	var composedGABC string
+
+	for i, ph := range a.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+
+		if a.breaks[i] {
+			composedGABC = strings.TrimSuffix(composedGABC, "\n") + "(Z)\n\n" // gabc code for a new line of score at the end of each paragraph
+		}
+	}
+
+	amen, ok := staff.SolemnAmen[a.Language]
+	if !ok {
+		return fmt.Errorf("amen in %q: %w", a.Language, gabcErrors.ErrUnknownLanguage)
+	}
+
+	// Adjust the ending of the composed GABC string
+	a.ComposedGABC = strings.TrimSuffix(composedGABC, "(:)(Z)\n\n") + "(::) " + amen
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph flex) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, recite, flexCadence); err != nil {
+		return "", fmt.Errorf("flex phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(,)\n" // gabc code for the "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph fullStop) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, recite, fullStopCadence); err != nil {
+		return "", fmt.Errorf("full stop phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
@@ -0,0 +1,106 @@
+package noveritis_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/latinsyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+	"github.com/ramon-reichert/gabcgen/internal/service/noveritis"
+)
+
+var ctx context.Context = context.Background()
+
+func TestComputus(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("computes the movable feasts", func(t *testing.T) {
+		is := is.New(t)
+
+		for _, want := range []noveritis.Dates{
+			{AshWednesday: day(2024, 2, 14), Easter: day(2024, 3, 31), Ascension: day(2024, 5, 9), Pentecost: day(2024, 5, 19), Advent: day(2024, 12, 1)},
+			{AshWednesday: day(2025, 3, 5), Easter: day(2025, 4, 20), Ascension: day(2025, 5, 29), Pentecost: day(2025, 6, 8), Advent: day(2025, 11, 30)},
+			{AshWednesday: day(2026, 2, 18), Easter: day(2026, 4, 5), Ascension: day(2026, 5, 14), Pentecost: day(2026, 5, 24), Advent: day(2026, 11, 29)},
+			{AshWednesday: day(2038, 3, 10), Easter: day(2038, 4, 25), Ascension: day(2038, 6, 3), Pentecost: day(2038, 6, 13), Advent: day(2038, 11, 28)}, // the latest Easter
+		} {
+			dates, err := noveritis.Computus(want.Easter.Year(), false)
+			is.NoErr(err)
+			is.Equal(dates, want)
+		}
+	})
+
+	t.Run("the Ascension may be transferred to Sunday", func(t *testing.T) {
+		is := is.New(t)
+
+		dates, err := noveritis.Computus(2025, true)
+		is.NoErr(err)
+		is.Equal(dates.Ascension, day(2025, 6, 1))
+	})
+
+	t.Run("writes the dates out as words", func(t *testing.T) {
+		is := is.New(t)
+
+		dates, err := noveritis.Computus(2024, false)
+		is.NoErr(err)
+
+		text, err := noveritis.Text(dates, "pt")
+		is.NoErr(err)
+		is.True(strings.Contains(text, "celebrado no dia trinta e um de março."))
+		is.True(strings.Contains(text, "o primeiro domingo do Advento, no dia primeiro de dezembro."))
+
+		text, err = noveritis.Text(dates, "la")
+		is.NoErr(err)
+		is.True(strings.Contains(text, "Die décima quarta Februárii erit dies Cínerum"))
+	})
+
+	t.Run("rejects years before the Gregorian calendar and unknown languages", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := noveritis.Computus(1582, false)
+		is.True(errors.Is(err, gabcErrors.ErrInvalidYear))
+
+		_, err = service.NewGabcGenAPI(mocksyllabifier.NewSyllabifier()).ComposeNoveritis(ctx, 2025, false, service.Options{Language: "en"})
+		is.True(errors.Is(err, gabcErrors.ErrUnknownLanguage))
+	})
+}
+
+func TestGenerateNoveritis(t *testing.T) {
+	t.Run("recites the announcement, answered by the Amen", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(mocksyllabifier.NewSyllabifier()).GenerateNoveritis(ctx, 2025)
+		is.NoErr(err)
+
+		is.True(strings.HasPrefix(composedGABC, "Ir(h)mãos(h) ca(h)rís(g)si(g)mos,(g) (,)\n"))
+		is.True(strings.Contains(composedGABC, "as(h) Cin(h)zas,(h) i(h)ní(h)cio(h) da(h) Qua(h)res(h)ma,(h) no(h) di(h)a(h) cin(h)co(h) de(h) mar(g)ço;(g) (,)\n"+
+			"a(h) As(h)cen(h)são(h) do(h) Se(h)nhor,(h) no(h) di(h)a(h) vin(h)te(h) e(h) no(h)ve(h) de(h) mai(g)o;(g) (,)\n"+
+			"Pen(h)te(h)cos(h)tes,(h) no(h) di(h)a(h) oi(h)to(h) de(h) ju(g)nho;(g) (,)\n"+
+			"o(h) pri(h)mei(h)ro(h) do(h)min(h)go(h) do(h) Ad(h)ven(h)to,(h) no(h) di(h)a(h) trin(h)ta(h) de(h) no(g)vem(f)bro.(f) (:)(Z)\n\n")) // the dates of 2025
+		is.True(strings.HasSuffix(composedGABC, "lou(h)vor(h) e(h) gló(h)ri(h)a(h) pe(h)los(h) sé(h)cu(h)los(h) dos(g) sé(f)cu(f)los.(f) (::) "+staff.SolemnAmen["pt"]))
+	})
+
+	t.Run("syllabifies the Latin announcement by the rules of Latin", func(t *testing.T) {
+		is := is.New(t)
+
+		gen := service.NewGabcGenAPI(mocksyllabifier.NewSyllabifier()) // the mock knows no Latin word
+		gen.Languages = map[string]words.Syllabifier{"la": latinsyllabifier.NewSyllabifier()}
+
+		score, err := gen.ComposeNoveritis(ctx, 2025, false, service.Options{Language: "la"})
+		is.NoErr(err)
+
+		is.True(strings.HasPrefix(score.GABC, "No(h)vé(h)ri(h)tis,(h) fra(h)tres(h) ca(h)rís(g)si(g)mi,(g) (,)\n"))
+		is.True(strings.Contains(score.GABC, "\n\nDi(h)e(h) vi(h)cé(h)si(h)ma(h) A(h)prí(h)lis(h) sanc(h)tum(h) Pas(h)cha(h) Dó(h)mi(h)ni(h) nos(h)tri(h) Ie(h)su(h) Chris(h)ti(h) "+
+			"cum(h) gáu(h)di(h)o(h) ce(h)le(g)brá(f)bi(f)mus.(f) (:)(Z)\n\n")) // Easter of 2025
+		is.True(strings.HasSuffix(score.GABC, "in(h) sǽ(h)cu(h)la(h) sæ(h)cu(g)ló(f)rum.(f) (::) "+staff.SolemnAmen["la"]))
+	})
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/oration"
+)
+
+// GenerateOration attaches GABC code to each syllable of the incomming lined text following the oration tone.
+// Each line is a phrase, typed by its position and punctuation; the conclusion formula goes on its own lines.
+func (gen GabcGen) GenerateOration(ctx context.Context, linedText string) (string, error) {
+	score, err := gen.ComposeOration(ctx, linedText, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeOration works like GenerateOration, taking the per-text options and returning the warnings along with the GABC.
+func (gen GabcGen) ComposeOration(ctx context.Context, linedText string, opts Options) (Score, error) {
+	newParagraphs, linedText, score, err := gen.buildParagraphs(ctx, linedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Oration: %w", err)
+	}
+
+	orationText := oration.New(opts.Language, linedText)
+
+	if err := orationText.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Oration: %w", err)
+	}
+
+	if err := orationText.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Oration: %w", err)
+	}
+
+	score.GABC = orationText.ComposedGABC
+
+	return score, nil
+}
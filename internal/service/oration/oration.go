@@ -0,0 +1,218 @@
+// Package oration handles specific phrase types that compose the melody of the presidential prayers of Mass:
+// the collect, the prayer over the offerings and the prayer after communion.
+package oration
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+type Oration struct {
+	LinedText    string
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the oration melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	Language     string           // language of the Amém, phrases.DefaultLanguage if empty
+	concluded    bool             // whether the oration ends with a conclusion formula, answered by the people
+}
+
+type ( // Phrase types that can occur in an Oration, following the traditional oration tone
+	flex       phrases.Phrase // flex = reciting tone, falling a minor third after the last accent, at a comma;
+	metrum     phrases.Phrase // metrum = reciting tone, falling a whole tone after the last accent, at the main pause;
+	punctum    phrases.Phrase // punctum = reciting tone, final cadence descending to the last accent, at the end of the prayer;
+	conclusion phrases.Phrase // conclusion = last phrase of the conclusion formula, like "Por Cristo, nosso Senhor.", dipping a tone before the last accent and falling on it.
+)
+
+// The cadences of the oration tone, all of them recited on the same note.
+var (
+	flexCadence       = cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoLa, After: staff.La}
+	metrumCadence     = cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoSi, After: staff.Si}
+	punctumCadence    = cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La}
+	conclusionCadence = cadence.Cadence{Before: []string{staff.Do, staff.Si}, Tonic: staff.SiLa, Oxytone: staff.SiLa, After: staff.La}
+)
+
+// ConclusionBeginnings are the lower case beginnings of the conclusion formulas, in Portuguese and in Latin.
+var ConclusionBeginnings = []string{
+	"por nosso senhor", "por cristo", "pelo mesmo", "vós que", "ele que", "que vive", "que viveis",
+	"per dominum", "per christum", "per eundem", "qui vivis", "qui tecum", "qui vivit",
+}
+
+// New creates a new oration struct with the language of its answer and the lined text.
+func New(language, linedText string) *Oration { // returning a pointer because this struct is going to be modified by its methods
+	return &Oration{
+		LinedText: linedText,
+		Language:  language,
+	}
+}
+
+// TypePhrases types the already built phrases: the body of the prayer is sung with flexes at its commas,
+// a metrum at its main pause and a punctum at its end, followed by the conclusion formula, if there is one.
+// The conclusion formula is only looked for in the last sentence, so a body line like "vós que..." does not end the body.
+// The main pause is the last phrase of the body ending with a colon or a semicolon, or the one before the punctum otherwise.
+// Paragraphs are not taken into account, since the whole text is a single prayer.
+func (o *Oration) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	var all []*phrases.Phrase
+	for _, p := range newParagraphs {
+		all = append(all, p.Phrases...)
+	}
+
+	body := all
+	var formula []*phrases.Phrase
+
+	// Scan back from the end for the first phrase of the last sentence
+	start := len(all) - 1
+	for start > 0 && !endsSentence(all[start-1].Text) {
+		start--
+	}
+
+	if isConclusion(all[start].Text) {
+		body, formula = all[:start], all[start:]
+	}
+
+	if len(body) == 0 {
+		return fmt.Errorf("typing phrase: %v - %w", all[0].Text, gabcErrors.ErrShortOration)
+	}
+
+	pause := -1
+	for i, ph := range body[:len(body)-1] {
+		if strings.HasSuffix(ph.Text, ":") || strings.HasSuffix(ph.Text, ";") {
+			pause = i
+		}
+	}
+
+	if pause < 0 {
+		pause = len(body) - 2 // -1 when the body has a single phrase, which is the punctum itself
+	}
+
+	for i, ph := range body {
+		switch i {
+		case len(body) - 1:
+			o.Phrases = append(o.Phrases, punctum{Text: ph.Text, Syllables: ph.Syllables, Directives: ph.Directives})
+		case pause:
+			o.Phrases = append(o.Phrases, metrum{Text: ph.Text, Syllables: ph.Syllables, Directives: ph.Directives})
+		default:
+			o.Phrases = append(o.Phrases, flex{Text: ph.Text, Syllables: ph.Syllables, Directives: ph.Directives})
+		}
+	}
+
+	for i, ph := range formula {
+		if i == len(formula)-1 {
+			o.Phrases = append(o.Phrases, conclusion{Text: ph.Text, Syllables: ph.Syllables, Directives: ph.Directives})
+			o.concluded = true
+			continue
+		}
+
+		o.Phrases = append(o.Phrases, flex{Text: ph.Text, Syllables: ph.Syllables, Directives: ph.Directives})
+	}
+
+	return nil
+}
+
+// isConclusion tells whether a phrase starts a conclusion formula.
+func isConclusion(text string) bool {
+	text = strings.ToLower(text)
+
+	for _, b := range ConclusionBeginnings {
+		if strings.HasPrefix(text, b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// endsSentence tells whether a phrase ends a sentence.
+func endsSentence(text string) bool {
+	text = strings.TrimRight(text, "»\"' ")
+
+	return strings.HasSuffix(text, ".") || strings.HasSuffix(text, "!") || strings.HasSuffix(text, "?")
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the oration and returns the composed GABC string.
+// A conclusion formula is followed by the "Amém" of the people, in the language of the oration.
+func (o *Oration) ApplyGabcMelodies() error {
+	var composedGABC string
+
+	for _, ph := range o.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+	}
+
+	// Adjust the ending of the composed GABC string
+	if o.concluded {
+		language := o.Language
+		if language == "" {
+			language = phrases.DefaultLanguage
+		}
+
+		amen, ok := staff.Amen[language]
+		if !ok {
+			return fmt.Errorf("amen in %q: %w", language, gabcErrors.ErrUnknownLanguage)
+		}
+
+		composedGABC = composedGABC + "\n" + amen
+	} else {
+		composedGABC = strings.TrimSuffix(composedGABC, "(:)\n") + "(::)"
+	}
+
+	o.ComposedGABC = composedGABC
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph flex) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, staff.Do, flexCadence); err != nil {
+		return "", fmt.Errorf("flex phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(,)\n" // gabc code for the "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph metrum) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, staff.Do, metrumCadence); err != nil {
+		return "", fmt.Errorf("metrum phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(;)\n" // gabc code for the "half bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph punctum) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, staff.Do, punctumCadence); err != nil {
+		return "", fmt.Errorf("punctum phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+// The conclusion formula closes with a final bar, since the people answer it.
+func (ph conclusion) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, staff.Do, conclusionCadence); err != nil {
+		return "", fmt.Errorf("conclusion phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(::)" // gabc code for the "double bar", to be added at the end of the oration
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
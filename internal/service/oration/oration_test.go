@@ -0,0 +1,68 @@
+package oration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGenerateOration(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("apply flex, metrum, punctum and conclusion using mockSyllabifier", func(t *testing.T) {
+		is := is.New(t)
+
+		a := "Na verdade, é digno e justo,\n" // paroxytone - flex
+		b := "Na verdade, digno e justo é;\n" // oxytone at the main pause - metrum
+		c := "Na verdade, digno, justo.\n"    // paroxytone - punctum
+		d := "Por Cristo, nosso Senhor.\n"    // oxytone - conclusion
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateOration(ctx, a+b+c+d)
+		is.NoErr(err)
+
+		expectedGABC := "Na(h) ver(h)da(h)de,(h) é(h) dig(h)no(h) e(h) jus(h)to,(f) (,)\nNa(h) ver(h)da(h)de,(h) dig(h)no(h) e(h) jus(h)to(h) é;(hg) (;)\nNa(h) ver(h)da(h)de,(h) dig(h)no,(g) jus(f)to.(f) (:)\nPor(h) Cris(h)to,(h) nos(h)so(g) Se(h)nhor.(gf) (::)\n<c><sp>R/</sp></c> A(f)mém.(f) (::)"
+
+		is.Equal(composedGABC, expectedGABC)
+	})
+
+	t.Run("the phrase before the punctum is the main pause, without a conclusion", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateOration(ctx, "Na verdade, é digno e justo,\n digno e justo é.")
+		is.NoErr(err)
+
+		is.Equal(composedGABC, "Na(h) ver(h)da(h)de,(h) é(h) dig(h)no(h) e(h) jus(h)to,(g) (;)\ndig(h)no(h) e(h) jus(h)to(g) é.(gf) (::)")
+	})
+
+	t.Run("a body line beginning like a conclusion formula is still sung as the body", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateOration(ctx, "Vós que é digno e justo,\n na verdade, digno, justo.\n Por Cristo, nosso Senhor.")
+		is.NoErr(err)
+
+		is.Equal(composedGABC, "Vós(h) que(h) é(h) dig(h)no(h) e(h) jus(h)to,(g) (;)\nna(h) ver(h)da(h)de,(h) dig(h)no,(g) jus(f)to.(f) (:)\nPor(h) Cris(h)to,(h) nos(h)so(g) Se(h)nhor.(gf) (::)\n<c><sp>R/</sp></c> A(f)mém.(f) (::)")
+	})
+
+	t.Run("the Amen of the people follows the language of the oration", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposeOration(ctx, "Na verdade, digno, justo.\n Por Cristo, nosso Senhor.", service.Options{Language: "la"})
+		is.NoErr(err)
+
+		is.Equal(score.GABC, "Na(h) ver(h)da(h)de,(h) dig(h)no,(g) jus(f)to.(f) (:)\nPor(h) Cris(h)to,(h) nos(h)so(g) Se(h)nhor.(gf) (::)\n<c><sp>R/</sp></c> A(f)men.(f) (::)")
+	})
+
+	t.Run("a conclusion formula alone is not an oration", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateOration(ctx, "Por Cristo, nosso Senhor.")
+		is.True(errors.Is(err, gabcErrors.ErrShortOration))
+	})
+}
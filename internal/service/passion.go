@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/passion"
+)
+
+// PassionScore is a composed Passion, optionally split into the parts of each singer.
+type PassionScore struct {
+	Score
+	Singers map[string]string // GABC of the words of each role alone, keyed by its marker, when asked to split
+}
+
+// GeneratePassion attaches GABC code to each syllable of the Passion, whose roles are introduced by the markers
+// "N." for the narrator, "†" for Christ and "S." for the other characters and the crowd. Each role is sung on its own tone.
+func (gen GabcGen) GeneratePassion(ctx context.Context, text string) (string, error) {
+	score, err := gen.ComposePassion(ctx, text, false, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposePassion works like GeneratePassion, taking the per-text options and returning the warnings along with the GABC.
+// When split is true, the words of each role are also returned alone, for its singer.
+func (gen GabcGen) ComposePassion(ctx context.Context, text string, split bool, opts Options) (PassionScore, error) {
+	// The text is sanitized before being split into sentences, as the readings are
+	text, sanitized := phrases.Sanitize(text)
+	p := passion.New(opts.Language, text)
+
+	newParagraphs, _, score, err := gen.buildParagraphs(ctx, p.LinedText, opts)
+	if err != nil {
+		return PassionScore{}, fmt.Errorf("generating Passion: %w", err)
+	}
+	score.Sanitized = sanitized
+
+	if err := p.TypePhrases(newParagraphs); err != nil {
+		return PassionScore{}, fmt.Errorf("generating Passion: %w", err)
+	}
+
+	if err := p.ApplyGabcMelodies(); err != nil {
+		return PassionScore{}, fmt.Errorf("generating Passion: %w", err)
+	}
+
+	score.GABC = p.ComposedGABC
+
+	passionScore := PassionScore{Score: score}
+	if split {
+		passionScore.Singers = make(map[string]string, len(p.Singers))
+		for role, gabc := range p.Singers {
+			passionScore.Singers[string(role)] = gabc
+		}
+	}
+
+	return passionScore, nil
+}
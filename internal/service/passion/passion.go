@@ -0,0 +1,228 @@
+// Package passion handles specific phrase types that compose the melody of the Passion, sung by three voices.
+package passion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+	"github.com/ramon-reichert/gabcgen/internal/service/readings"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+// Role is one of the voices of the Passion, named by the marker that introduces its words in the text.
+type Role string
+
+const (
+	Chronista Role = "N." // the narrator
+	Christus  Role = "†"  // the words of Christ
+	Synagoga  Role = "S." // the other characters and the crowd
+)
+
+// Roles are the voices of the Passion, in the order they are usually listed.
+var Roles = []Role{Chronista, Christus, Synagoga}
+
+// markers are the words that introduce a role in the text. A "+" is taken for the cross, which is not found on every keyboard.
+var markers = map[string]Role{
+	"N.": Chronista,
+	"†":  Christus,
+	"+":  Christus,
+	"S.": Synagoga,
+}
+
+// sp are the GABC special characters that show the role at the beginning of its words.
+var sp = map[Role]string{
+	Chronista: "<c><sp>N.</sp></c> ",
+	Christus:  "<c><sp>+</sp></c> ", // gregorio draws a cross for <sp>+</sp>
+	Synagoga:  "<c><sp>S.</sp></c> ",
+}
+
+// formulas are the reciting note and the cadences of the tone of a role.
+type formulas struct {
+	recite   string
+	flex     cadence.Cadence // at a comma, a colon or a semicolon
+	fullStop cadence.Cadence // at the end of a sentence
+	question cadence.Cadence // at a question mark
+}
+
+// Christ sings on the lowest note, the narrator in the middle, and the crowd on the highest one.
+var tones = map[Role]formulas{
+	Chronista: {
+		recite:   staff.Do,
+		flex:     cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoLa, After: staff.La},
+		fullStop: cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La},
+		question: cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.Si, Oxytone: staff.SiDo, After: staff.Do},
+	},
+	Christus: {
+		recite:   staff.La,
+		flex:     cadence.Cadence{Tonic: staff.La, Oxytone: staff.LaSol, After: staff.Sol},
+		fullStop: cadence.Cadence{Before: []string{staff.Sol}, Tonic: staff.Fa, Oxytone: staff.SolFa, After: staff.Fa},
+		question: cadence.Cadence{Before: []string{staff.Sol}, Tonic: staff.Sol, Oxytone: staff.SolLa, After: staff.La},
+	},
+	Synagoga: {
+		recite:   staff.Re,
+		flex:     cadence.Cadence{Tonic: staff.Re, Oxytone: staff.ReDo, After: staff.Do},
+		fullStop: cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.Si},
+		question: cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Do, Oxytone: staff.DoRe, After: staff.Re},
+	},
+}
+
+type Passion struct {
+	LinedText    string           // a paragraph for the words of each role, with a line for each phrase
+	Roles        []Role           // role of each paragraph
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the Passion melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	Singers      map[Role]string  // composed GABC string of the words of each role alone, also generated by the ApplyGabcMelodies method
+	roleOf       []Role           // role of each phrase
+}
+
+type ( // Phrase types that can occur in the words of each role, chosen by the punctuation at their end
+	flex struct { // flex = reciting tone, falling after the last accent, inside a sentence;
+		phrases.Phrase
+		formulas formulas
+	}
+	fullStop struct { // fullStop = reciting tone, final cadence, at the end of a sentence;
+		phrases.Phrase
+		formulas formulas
+	}
+	interrogation struct { // interrogation = reciting tone, rising after the last accent, at a question mark.
+		phrases.Phrase
+		formulas formulas
+	}
+)
+
+// New creates a new Passion struct from its text, where each role is introduced by its marker: "N.", "†" (or "+") and "S.".
+// Words before the first marker are sung by the narrator. The language tells the abbreviations that do not end a sentence.
+func New(language, text string) *Passion { // returning a pointer because this struct is going to be modified by its methods
+	passion := &Passion{}
+
+	var paragraphs []string
+	role := Chronista
+	var segment []string
+
+	flush := func() {
+		if len(segment) > 0 {
+			paragraphs = append(paragraphs, readings.SplitSentences(strings.Join(segment, " "), language))
+			passion.Roles = append(passion.Roles, role)
+		}
+		segment = nil
+	}
+
+	for _, w := range strings.Fields(text) {
+		if r, ok := markers[w]; ok {
+			flush()
+			role = r
+			continue
+		}
+
+		segment = append(segment, w)
+	}
+	flush()
+
+	passion.LinedText = strings.Join(paragraphs, "\n\n")
+
+	return passion
+}
+
+// TypePhrases types the already built phrases of each role by the punctuation at their end.
+// The last phrase of the words of a role always closes them with the full stop cadence, unless it is a question.
+func (passion *Passion) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	if len(newParagraphs) != len(passion.Roles) {
+		return fmt.Errorf("typing phrases: %v roles split into %v paragraphs", len(passion.Roles), len(newParagraphs))
+	}
+
+	for n, p := range newParagraphs {
+		role := passion.Roles[n]
+		f := tones[role]
+
+		for i, ph := range p.Phrases {
+			switch mark := phrases.LastMark(ph.Text); {
+			case mark == '?':
+				passion.Phrases = append(passion.Phrases, interrogation{Phrase: *ph, formulas: f})
+			case i == len(p.Phrases)-1, mark == '.', mark == '!':
+				passion.Phrases = append(passion.Phrases, fullStop{Phrase: *ph, formulas: f})
+			default:
+				passion.Phrases = append(passion.Phrases, flex{Phrase: *ph, formulas: f})
+			}
+
+			passion.roleOf = append(passion.roleOf, role)
+		}
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the Passion and returns the composed GABC string,
+// with the marker of each role at the beginning of its words. The words of each role are also composed alone, for its singer.
+func (passion *Passion) ApplyGabcMelodies() error {
+	var composedGABC string
+	passion.Singers = make(map[Role]string)
+
+	var part string // words of the current role
+	for i, ph := range passion.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		role := passion.roleOf[i]
+		if part == "" {
+			part = sp[role]
+		}
+
+		part = part + gabcPhrase
+
+		if i == len(passion.Phrases)-1 || passion.roleOf[i+1] != role {
+			part = strings.TrimSuffix(part, "(:)\n") + "(::)(Z)\n\n" // gabc code for the "double bar" and a new line of score when the role changes
+			composedGABC = composedGABC + part
+			passion.Singers[role] = passion.Singers[role] + part
+			part = ""
+		}
+	}
+
+	// Adjust the ending of the composed GABC strings
+	passion.ComposedGABC = strings.TrimSuffix(composedGABC, "(Z)\n\n")
+	for role, s := range passion.Singers {
+		passion.Singers[role] = strings.TrimSuffix(s, "(Z)\n\n")
+	}
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph flex) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.flex); err != nil {
+		return "", fmt.Errorf("flex phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(,)\n" // gabc code for the "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph fullStop) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.fullStop); err != nil {
+		return "", fmt.Errorf("full stop phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph interrogation) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.question); err != nil {
+		return "", fmt.Errorf("interrogation phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
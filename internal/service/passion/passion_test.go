@@ -0,0 +1,46 @@
+package passion_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGeneratePassion(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("sings each role on its own tone, marking who sings", func(t *testing.T) {
+		is := is.New(t)
+
+		inputText := "N. Na verdade, Cristo é digno.\n† Por isso, é justo.\nN. Na verdade:\nS. É justo?"
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposePassion(ctx, inputText, true, service.Options{})
+		is.NoErr(err)
+
+		narrator := "<c><sp>N.</sp></c> Na(h) ver(h)da(h)de,(f) (,)\nCris(h)to(h) é(g) dig(f)no.(f) (::)(Z)\n\n"
+		christ := "<c><sp>+</sp></c> Por(f) is(f)so,(e) (,)\né(e) jus(d)to.(d) (::)(Z)\n\n" // a third below the narrator
+		crowd := "<c><sp>S.</sp></c> É(h) jus(h)to?(i) (::)"                                // rising to the highest note at the question
+
+		is.Equal(score.GABC, narrator+christ+"<c><sp>N.</sp></c> Na(h) ver(g)da(f)de:(f) (::)(Z)\n\n"+crowd)
+
+		is.Equal(score.Singers["N."], narrator+"<c><sp>N.</sp></c> Na(h) ver(g)da(f)de:(f) (::)")
+		is.Equal(score.Singers["†"], strings.TrimSuffix(christ, "(Z)\n\n"))
+		is.Equal(score.Singers["S."], crowd)
+	})
+
+	t.Run("only splits the singers when asked", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposePassion(ctx, "+ Na verdade.", false, service.Options{})
+		is.NoErr(err)
+
+		is.Equal(score.GABC, "<c><sp>+</sp></c> Na(f) ver(e)da(d)de.(d) (::)")
+		is.Equal(len(score.Singers), 0)
+	})
+}
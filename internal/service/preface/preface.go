@@ -155,7 +155,7 @@ func (ph firsts) ApplyMelody() (string, error) {
 			return "", fmt.Errorf("firsts phrase: %v: %w ", ph.Text, gabcErrors.ErrShortPhrase)
 		}
 
-	} else if ph.Syllables[i-1].IsTonic && !ph.Syllables[i-1].IsLast { // exception case
+	} else if i > 0 && ph.Syllables[i-1].IsTonic && !ph.Syllables[i-1].IsLast { // exception case
 		ph.Syllables[i].GABC = string(ph.Syllables[i].Char) + staff.Si
 		i--
 
@@ -3,6 +3,7 @@ package preface_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/matryer/is"
@@ -60,4 +61,16 @@ func TestGeneratePreface(t *testing.T) {
 
 		is.Equal(norm.NFC.String(composedGABC), norm.NFC.String(expectedGABC))
 	})
+
+	t.Run("function words do not anchor cadences", func(t *testing.T) {
+		is := is.New(t)
+
+		inputText := "Na verdade,\n Na verdade, é digno e justo\n Na verdade, digno e justo é."
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GeneratePreface(ctx, "", inputText)
+		is.NoErr(err) // "na" is atonic, so the firsts melody reaches the first syllable without a tonic before it
+
+		_, proper, _ := strings.Cut(composedGABC, "\n\n")
+		is.Equal(proper, "<c><sp>V/</sp></c> Na(f) ver(gf)da(fg)de,(g) (;)\nNa(g) ver(g)da(g)de,(g) é(g) dig(g)no(f) e(g) jus(h)to(g) (,)\nNa(g) ver(g)da(g)de,(g) dig(g)no(g) e(fe) jus(ef)to(g) é.(fgf) (::)")
+	})
 }
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+)
+
+// Preview shows how each phrase of a text is going to be syllabified, so the user can correct it before composing the score.
+type Preview struct {
+	Phrases    []PhrasePreview
+	Warnings   []phrases.Warning
+	Normalized []phrases.Normalization
+	Sanitized  []phrases.Change
+}
+
+type PhrasePreview struct {
+	Text  string
+	Words []phrases.WordPreview
+}
+
+// PreviewSyllables syllabifies the lined text the same way ComposePreface does, without applying any melody.
+// Words that cannot be syllabified are flagged in the preview instead of failing the whole text.
+func (gen GabcGen) PreviewSyllables(ctx context.Context, linedText string, opts Options) (Preview, error) {
+	linedText, sanitized := phrases.Sanitize(linedText)
+
+	newParagraphs, err := phrases.DistributeText(linedText)
+	if err != nil {
+		return Preview{}, fmt.Errorf("previewing syllables: %w", err)
+	}
+
+	if err := gen.preparePhrases(newParagraphs, opts); err != nil {
+		return Preview{}, fmt.Errorf("previewing syllables: %w", err)
+	}
+
+	preview := Preview{Sanitized: sanitized}
+
+	for _, p := range newParagraphs {
+		for _, ph := range p.Phrases {
+			preview.Phrases = append(preview.Phrases, PhrasePreview{Text: ph.Text, Words: ph.PreviewWords(ctx)})
+			preview.Warnings = append(preview.Warnings, ph.Warnings...)
+			preview.Normalized = append(preview.Normalized, ph.Normalized...)
+		}
+	}
+
+	return preview, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/psalmody"
+)
+
+// GeneratePsalm attaches GABC code to each syllable of the incomming verses following the given psalm tone, like "8G" or "per".
+// Each verse comes in a line, with a "*" at its mediant and, when long, a "†" at its flex.
+func (gen GabcGen) GeneratePsalm(ctx context.Context, tone, verses string) (string, error) {
+	score, err := gen.ComposePsalm(ctx, tone, verses, false, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposePsalm works like GeneratePsalm, taking the per-text options and returning the warnings along with the GABC.
+// When doxology is true, the "Glória ao Pai" is sung after the verses, in the language of the options.
+func (gen GabcGen) ComposePsalm(ctx context.Context, tone, verses string, doxology bool, opts Options) (Score, error) {
+	t, differentia, err := psalmody.ParseTone(tone)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Psalm: %w", err)
+	}
+
+	if doxology {
+		language := opts.Language
+		if language == "" {
+			language = phrases.DefaultLanguage
+		}
+
+		gloria, ok := psalmody.Doxology[language]
+		if !ok {
+			return Score{}, fmt.Errorf("generating Psalm: doxology in %q: %w", language, gabcErrors.ErrUnknownLanguage)
+		}
+
+		verses = verses + "\n" + gloria
+	}
+
+	return gen.composePsalm(ctx, psalmody.New(t, differentia, verses), opts)
+}
+
+// composePsalm builds the phrases of the already split verses of a psalm and sings them on its tone.
+func (gen GabcGen) composePsalm(ctx context.Context, psalm *psalmody.Psalm, opts Options) (Score, error) {
+	newParagraphs, _, score, err := gen.buildParagraphs(ctx, psalm.LinedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Psalm: %w", err)
+	}
+
+	if err := psalm.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Psalm: %w", err)
+	}
+
+	if err := psalm.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Psalm: %w", err)
+	}
+
+	score.GABC = psalm.ComposedGABC
+
+	return score, nil
+}
+
+// ComposeCanticle attaches GABC code to a gospel canticle of the bundled catalogue, like "magnificat", sung on the given psalm tone
+// with the intonation on every verse and closed by the "Glória ao Pai". The antiphon, already in GABC since antiphons have
+// melodies of their own, is sung before and after the canticle when given.
+func (gen GabcGen) ComposeCanticle(ctx context.Context, canticle, tone, antiphon string, opts Options) (Score, error) {
+	c, err := psalmody.FindCanticle(canticle)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Canticle: %w", err)
+	}
+
+	t, differentia, err := psalmody.ParseTone(tone)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Canticle: %w", err)
+	}
+
+	// The verses and the doxology must be sung in the language of the catalogue, syllabified as such
+	if opts.Language != "" && opts.Language != psalmody.CanticlesLanguage {
+		return Score{}, fmt.Errorf("generating Canticle: in %q: %w", opts.Language, gabcErrors.ErrCanticleLanguage)
+	}
+
+	if c.Solemn {
+		t.Intonation = t.Solemn
+	}
+
+	psalm := psalmody.New(t, differentia, c.Verses+"\n"+psalmody.Doxology[psalmody.CanticlesLanguage])
+	psalm.IntoneEveryVerse = true
+
+	score, err := gen.composePsalm(ctx, psalm, opts)
+	if err != nil {
+		return Score{}, err
+	}
+
+	if antiphon = strings.TrimSpace(antiphon); antiphon != "" {
+		score.GABC = antiphon + "\n\n" + score.GABC + "\n\n" + antiphon
+	}
+
+	return score, nil
+}
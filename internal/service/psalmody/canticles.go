@@ -0,0 +1,71 @@
+// Package psalmody handles specific phrase types that compose the melody of psalms and canticles sung on the Gregorian psalm tones.
+package psalmody
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+)
+
+// Canticle is a gospel canticle, with its verses marked for the psalm tones.
+type Canticle struct {
+	Title  string
+	Verses string // one verse per line, marked with * at the mediant
+	Solemn bool   // whether tradition sings it with the solemn intonation of the tone
+}
+
+// CanticlesLanguage is the language of the canticles of the catalogue, which are only sung in it.
+const CanticlesLanguage = "pt"
+
+// canticles are the gospel canticles of the Liturgy of the Hours, in Portuguese, keyed by their Latin incipit.
+// Every verse starts with the intonation of the tone, which is the solemn one for the Magnificat and the Benedictus.
+var canticles = map[string]Canticle{
+	"magnificat": {
+		Title: "Cântico de Maria (Lc 1,46-55)",
+		Verses: "A minha alma engrandece o Senhor * e se alegrou o meu espírito em Deus, meu Salvador,\n" +
+			"pois ele viu a pequenez de sua serva, * desde agora as gerações hão de chamar-me de bendita.\n" +
+			"O Poderoso fez por mim maravilhas * e Santo é o seu nome!\n" +
+			"Seu amor, de geração em geração, * chega a todos que o respeitam.\n" +
+			"Demonstrou o poder de seu braço, * dispersou os orgulhosos.\n" +
+			"Derrubou os poderosos de seus tronos * e os humildes exaltou.\n" +
+			"De bens saciou os famintos * e despediu, sem nada, os ricos.\n" +
+			"Acolheu Israel, seu servidor, * fiel ao seu amor,\n" +
+			"como havia prometido aos nossos pais, * em favor de Abraão e de seus filhos, para sempre.",
+		Solemn: true,
+	},
+	"benedictus": {
+		Title: "Cântico de Zacarias (Lc 1,68-79)",
+		Verses: "Bendito seja o Senhor Deus de Israel, * porque a seu povo visitou e libertou;\n" +
+			"e fez surgir um poderoso Salvador * na casa de Davi, seu servidor,\n" +
+			"como falara pela boca de seus santos, * os profetas desde os tempos mais antigos,\n" +
+			"para salvar-nos do poder dos inimigos * e da mão de todos quantos nos odeiam.\n" +
+			"Assim mostrou misericórdia a nossos pais, * recordando a sua santa Aliança\n" +
+			"e o juramento a Abraão, o nosso pai, * de conceder-nos que, libertos do inimigo,\n" +
+			"a ele nós sirvamos sem temor † em santidade e em justiça diante dele, * enquanto perdurarem nossos dias.\n" +
+			"Serás profeta do Altíssimo, ó menino, * pois irás andando à frente do Senhor\n" +
+			"para aplainar e preparar os seus caminhos, * anunciando ao seu povo a salvação,\n" +
+			"que está na remissão de seus pecados, * pela bondade e compaixão de nosso Deus,\n" +
+			"que sobre nós fará brilhar o Sol nascente, * para iluminar a quantos jazem entre as trevas\n" +
+			"e na sombra da morte estão sentados * e para dirigir os nossos passos, guiando-os no caminho da paz.",
+		Solemn: true,
+	},
+	"nunc-dimittis": {
+		Title: "Cântico de Simeão (Lc 2,29-32)",
+		Verses: "Deixai, agora, vosso servo ir em paz, * conforme prometestes, ó Senhor.\n" +
+			"Pois meus olhos viram vossa salvação * que preparastes ante a face das nações:\n" +
+			"uma Luz que brilhará para os gentios * e para a glória de Israel, o vosso povo.",
+	},
+}
+
+// FindCanticle returns the gospel canticle with the given name, like "magnificat", "benedictus" or "nunc-dimittis".
+func FindCanticle(name string) (Canticle, error) {
+	key := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+
+	c, ok := canticles[key]
+	if !ok {
+		return Canticle{}, fmt.Errorf("canticle %q: %w", name, gabcErrors.ErrUnknownCanticle)
+	}
+
+	return c, nil
+}
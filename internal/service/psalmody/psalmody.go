@@ -0,0 +1,310 @@
+// Package psalmody handles specific phrase types that compose the melody of psalms and canticles sung on the Gregorian psalm tones.
+package psalmody
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+// Markers of the parts of a verse.
+const (
+	FlexMarker    = "†"
+	MediantMarker = "*"
+)
+
+// Doxology is the "Glória ao Pai" that may close a psalm, in each language, as verses with their markers.
+var Doxology = map[string]string{
+	"pt": "Glória ao Pai e ao Filho * e ao Espírito Santo.\nComo era no princípio, agora e sempre, * pelos séculos dos séculos. Amém.",
+	"la": "Glória Patri, et Fílio, * et Spirítui Sancto.\nSicut erat in princípio, et nunc et semper, * et in sǽcula sæculórum. Amen.",
+}
+
+type role int
+
+const (
+	flexRole role = iota
+	mediantRole
+	terminationRole
+)
+
+type Psalm struct {
+	Tone             Tone
+	Differentia      string
+	IntoneEveryVerse bool             // whether every verse starts with the intonation, like in the gospel canticles, instead of only the first one
+	LinedText        string           // each part of a verse in a line, with a blank line between the verses, as split by New
+	Phrases          []PhraseMelodyer // typed phrases whose behaviors compose the psalm melodies
+	ComposedGABC     string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	roles            [][]role         // roles of the parts of each verse
+}
+
+type ( // Phrase types that can occur in a Psalm, chosen by the markers of the verses
+	flex struct { // flex = reciting tone, falling at the †, in long verses;
+		phrases.Phrase
+		notes notes
+	}
+	mediant struct { // mediant = intonation, reciting tone and mediant cadence, at the *;
+		phrases.Phrase
+		notes notes
+	}
+	termination struct { // termination = reciting tone and the cadence of the differentia, at the end of the verse.
+		phrases.Phrase
+		notes notes
+	}
+)
+
+// notes are the notes given by the tone to a part of a verse.
+type notes struct {
+	intonation []string // nil when the part is not intoned
+	tenor      string
+	cadence    cadence
+}
+
+// New creates a new psalm struct with the tone and the verses, one per line, whose parts are marked with † and *.
+// A verse without a * is sung on the termination alone.
+func New(tone Tone, differentia, verses string) *Psalm { // returning a pointer because this struct is going to be modified by its methods
+	psalm := &Psalm{
+		Tone:        tone,
+		Differentia: differentia,
+	}
+
+	var lined []string
+	for line := range strings.Lines(verses) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var parts []string
+		var roles []role
+
+		first, second, hasMediant := strings.Cut(line, MediantMarker)
+		if !hasMediant {
+			first, second = "", first
+		}
+
+		for _, half := range []struct {
+			text string
+			role role
+		}{{first, mediantRole}, {second, terminationRole}} {
+			if strings.TrimSpace(half.text) == "" {
+				continue
+			}
+
+			flexes := strings.Split(half.text, FlexMarker)
+			for i, part := range flexes {
+				part = strings.TrimSpace(part)
+				if part == "" {
+					continue
+				}
+
+				parts = append(parts, part)
+				if i < len(flexes)-1 {
+					roles = append(roles, flexRole)
+				} else {
+					roles = append(roles, half.role)
+				}
+			}
+		}
+
+		if len(parts) > 0 {
+			lined = append(lined, strings.Join(parts, "\n"))
+			psalm.roles = append(psalm.roles, roles)
+		}
+	}
+
+	psalm.LinedText = strings.Join(lined, "\n\n")
+
+	return psalm
+}
+
+// TypePhrases types the already built phrases of each verse by the marker that ends them.
+func (psalm *Psalm) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	ending, ok := psalm.Tone.Terminations[psalm.Differentia]
+	if !ok {
+		return fmt.Errorf("typing phrases: psalm tone %v%v: %w", psalm.Tone.Name, psalm.Differentia, gabcErrors.ErrUnknownPsalmTone)
+	}
+
+	if len(newParagraphs) != len(psalm.roles) {
+		return fmt.Errorf("typing phrases: %v verses split into %v paragraphs", len(psalm.roles), len(newParagraphs))
+	}
+
+	tenor2 := psalm.Tone.Tenor
+	if psalm.Tone.Tenor2 != "" {
+		tenor2 = psalm.Tone.Tenor2
+	}
+
+	for n, p := range newParagraphs {
+		roles := psalm.roles[n]
+		if len(p.Phrases) != len(roles) {
+			return fmt.Errorf("typing phrases: verse %v: %v parts split into %v phrases", n+1, len(roles), len(p.Phrases))
+		}
+
+		intone := n == 0 || psalm.IntoneEveryVerse
+		second := false // whether the part is in the second half of the verse, after the mediant
+
+		for i, ph := range p.Phrases {
+			nt := notes{tenor: psalm.Tone.Tenor}
+			if second {
+				nt.tenor = tenor2
+			}
+
+			if intone && i == 0 {
+				nt.intonation = psalm.Tone.Intonation
+			}
+
+			switch roles[i] {
+			case flexRole:
+				nt.cadence = psalm.Tone.Flex
+				psalm.Phrases = append(psalm.Phrases, flex{Phrase: *ph, notes: nt})
+			case mediantRole:
+				nt.cadence = psalm.Tone.Mediant
+				psalm.Phrases = append(psalm.Phrases, mediant{Phrase: *ph, notes: nt})
+				second = true
+			case terminationRole:
+				nt.cadence = ending
+				nt.tenor = tenor2
+				psalm.Phrases = append(psalm.Phrases, termination{Phrase: *ph, notes: nt})
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the psalm and returns the composed GABC string, starting with the clef of the tone.
+func (psalm *Psalm) ApplyGabcMelodies() error {
+	composedGABC := "(" + psalm.Tone.Clef + ") "
+
+	for _, ph := range psalm.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+	}
+
+	// Adjust the ending of the composed GABC string
+	psalm.ComposedGABC = strings.TrimSuffix(composedGABC, "\n\n")
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph flex) ApplyMelody() (string, error) {
+	if err := sing(ph.Syllables, ph.notes); err != nil {
+		return "", fmt.Errorf("flex phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := FlexMarker + "(,)\n" // the flex sign with a "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph mediant) ApplyMelody() (string, error) {
+	if err := sing(ph.Syllables, ph.notes); err != nil {
+		return "", fmt.Errorf("mediant phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := MediantMarker + "(:)\n" // the asterisk with a "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph termination) ApplyMelody() (string, error) {
+	if err := sing(ph.Syllables, ph.notes); err != nil {
+		return "", fmt.Errorf("termination phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(::)\n\n" // gabc code for the "double bar", to be added at the end of the verse
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// sing attaches the notes of a part of a verse to its syllables. Reading from the end, the accents of the cadence are anchored
+// on the last stressed syllables, the preparatory notes go to the syllables right before them, and the intonation to the first ones,
+// when there is room for it; the remaining syllables are recited on the tenor.
+// A cadence accent that finds no stressed syllable falls on the syllable where a paroxytone would have it.
+func sing(syllables []*words.Syllable, n notes) error {
+	if len(syllables) == 0 {
+		return gabcErrors.ErrShortPhrase
+	}
+
+	// Find the accents from the end
+	accents := make([]int, len(n.cadence.accents))
+	limit := len(syllables) - 1
+
+	for k := len(accents) - 1; k >= 0; k-- {
+		if limit < 0 {
+			return gabcErrors.ErrShortPhrase
+		}
+
+		t := limit
+		for t >= 0 && !syllables[t].IsTonic {
+			t--
+		}
+
+		if t < 0 {
+			t = max(limit-1, 0)
+		}
+
+		accents[k] = t
+		limit = t - 1
+	}
+
+	// Add GABC code to the accents and to the unaccented syllables after them
+	for k, t := range accents {
+		a := n.cadence.accents[k]
+
+		next := len(syllables)
+		if k < len(accents)-1 {
+			next = accents[k+1]
+		}
+
+		if t == next-1 {
+			syllables[t].GABC = string(syllables[t].Char) + "(" + a.tonic + a.after + ")"
+			continue
+		}
+
+		syllables[t].GABC = string(syllables[t].Char) + "(" + a.tonic + ")"
+
+		after := a.after
+		if after == "" {
+			after = a.tonic
+		}
+
+		for i := t + 1; i < next; i++ {
+			syllables[i].GABC = string(syllables[i].Char) + "(" + after + ")"
+		}
+	}
+
+	// Add GABC code to the preparatory syllables
+	i := accents[0] - 1
+	for p := len(n.cadence.preparatory) - 1; p >= 0 && i >= 0; p-- {
+		syllables[i].GABC = string(syllables[i].Char) + "(" + n.cadence.preparatory[p] + ")"
+		i--
+	}
+
+	// Add GABC code to the intonation, if there is room for it, and to the reciting syllables
+	start := 0
+	if len(n.intonation) <= i+1 {
+		for ; start < len(n.intonation); start++ {
+			syllables[start].GABC = string(syllables[start].Char) + "(" + n.intonation[start] + ")"
+		}
+	}
+
+	for ; start <= i; start++ {
+		syllables[start].GABC = string(syllables[start].Char) + "(" + n.tenor + ")"
+	}
+
+	return nil
+}
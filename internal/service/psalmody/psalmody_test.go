@@ -0,0 +1,121 @@
+package psalmody_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/psalmody"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGeneratePsalm(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("sings flex, mediant and termination, intoning only the first verse", func(t *testing.T) {
+		is := is.New(t)
+
+		inputText := "Na verdade é digno † e justo * por Cristo, nosso Senhor.\nNa verdade é digno * e justo."
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GeneratePsalm(ctx, "8G", inputText)
+		is.NoErr(err)
+
+		expectedGABC := "(c4) Na(g) ver(h)da(j)de(j) é(j) dig(j)no(h) †(,)\ne(j) jus(k)to(j) *(:)\npor(j) Cris(j)to,(j) nos(j)so(h) Se(j)nhor.(hg) (::)\n\n" +
+			"Na(j) ver(j)da(j)de(j) é(j) dig(k)no(j) *(:)\ne(j) jus(h)to.(g) (::)" // the preparatory notes of the termination go to the syllables before its accent, whatever their stress
+
+		is.Equal(composedGABC, expectedGABC)
+	})
+
+	t.Run("rejects unknown tones and differentiae", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GeneratePsalm(ctx, "9", "Na verdade * é digno.")
+		is.True(errors.Is(err, gabcErrors.ErrUnknownPsalmTone))
+
+		_, err = service.NewGabcGenAPI(syllabifier).GeneratePsalm(ctx, "8Z", "Na verdade * é digno.")
+		is.True(errors.Is(err, gabcErrors.ErrUnknownPsalmTone))
+	})
+
+	t.Run("the differentia is read whatever its case", func(t *testing.T) {
+		is := is.New(t)
+
+		upper, err := service.NewGabcGenAPI(syllabifier).GeneratePsalm(ctx, "8G", "Na verdade * é digno.")
+		is.NoErr(err)
+
+		lower, err := service.NewGabcGenAPI(syllabifier).GeneratePsalm(ctx, "8g", "Na verdade * é digno.")
+		is.NoErr(err)
+		is.Equal(lower, upper)
+
+		_, differentia, err := psalmody.ParseTone("1d")
+		is.NoErr(err)
+		is.Equal(differentia, "D")
+	})
+
+	t.Run("the doxology is only known in pt and la", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).ComposePsalm(ctx, "8G", "Na verdade * é digno.", true, service.Options{Language: "en"})
+		is.True(errors.Is(err, gabcErrors.ErrUnknownLanguage))
+	})
+}
+
+func TestComposeCanticle(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("every verse of the catalogue has a mediant", func(t *testing.T) {
+		is := is.New(t)
+
+		for _, name := range []string{"Magnificat", "benedictus", "Nunc Dimittis"} {
+			c, err := psalmody.FindCanticle(name)
+			is.NoErr(err)
+
+			for line := range strings.Lines(c.Verses) {
+				is.True(strings.Count(line, psalmody.MediantMarker) == 1) // a verse of the canticle misses its mediant
+			}
+		}
+	})
+
+	t.Run("intones every verse, closed by the doxology, between the antiphons", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposeCanticle(ctx, "nunc dimittis", "1", "(c4) A(g)men.(h) (::)", service.Options{})
+		is.NoErr(err)
+
+		expectedGABC := "(c4) A(g)men.(h) (::)\n\n" +
+			"(c4) Dei(f)xai,(gh) a(h)go(h)ra,(h) vos(h)so(h) ser(h)vo(h) ir(g) em(h) paz,(ixih) *(:)\ncon(h)for(h)me(h) pro(h)me(h)tes(h)tes,(h) ó(g) Se(f)nhor.(ed) (::)\n\n" +
+			"Pois(f) meus(gh) o(h)lhos(h) vi(h)ram(h) vos(h)sa(h) sal(g)va(h)ção(ixih) *(:)\nque(h) pre(h)pa(h)ras(h)tes(h) an(h)te(h) a(h) fa(h)ce(h) das(g) na(f)ções:(ed) (::)\n\n" + // the intonation on every verse
+			"u(f)ma(gh) Luz(h) que(h) bri(h)lha(h)rá(h) pa(h)ra(h) os(g) gen(h)ti(ixi)os(h) *(:)\ne(h) pa(h)ra(h) a(h) gló(h)ri(h)a(h) de(h) Is(h)ra(h)el,(h) o(h) vos(g)so(f) po(e)vo.(d) (::)\n\n" +
+			"Gló(f)ri(gh)a(h) ao(h) Pai(h) e(g) ao(h) Fi(ixi)lho(h) *(:)\ne(h) ao(h) Es(h)pí(h)ri(g)to(f) San(e)to.(d) (::)\n\n" +
+			"Co(f)mo(gh) e(h)ra(h) no(h) prin(h)cí(h)pio,(h) a(h)go(h)ra(g) e(h) sem(ixi)pre,(h) *(:)\npe(h)los(h) sé(h)cu(h)los(h) dos(h) sé(h)cu(h)los.(g) A(f)mém.(ed) (::)" +
+			"\n\n(c4) A(g)men.(h) (::)"
+
+		is.Equal(score.GABC, expectedGABC)
+
+		_, err = service.NewGabcGenAPI(syllabifier).ComposeCanticle(ctx, "te deum", "8G", "", service.Options{})
+		is.True(errors.Is(err, gabcErrors.ErrUnknownCanticle))
+	})
+
+	t.Run("the canticles are only sung in the language of the catalogue", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).ComposeCanticle(ctx, "magnificat", "1", "", service.Options{Language: "la"})
+		is.True(errors.Is(err, gabcErrors.ErrCanticleLanguage))
+	})
+
+	t.Run("the Magnificat takes the solemn intonation on every verse", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposeCanticle(ctx, "magnificat", "1", "", service.Options{})
+		is.NoErr(err)
+
+		is.True(strings.HasPrefix(score.GABC, "(c4) A(f) mi(g)nha(h) al(h)ma(h)"))
+		is.True(strings.Contains(score.GABC, "\n\npois(f) e(g)le(h) viu(h)"))
+		is.True(strings.Contains(score.GABC, "\n\nGló(f)ri(g)a(h) ao(h) Pai(h)")) // the doxology too
+	})
+}
@@ -0,0 +1,162 @@
+// Package psalmody handles specific phrase types that compose the melody of psalms and canticles sung on the Gregorian psalm tones.
+package psalmody
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+)
+
+// Tone is a Gregorian psalm tone: an intonation and a tenor, a flex, a mediant closing the first half of each verse
+// and one of its terminations closing the second half. Notes are written in GABC, without parentheses, for the clef of the tone.
+type Tone struct {
+	Name         string
+	Clef         string
+	Intonation   []string           // notes of the first syllables of the verse, one per syllable
+	Solemn       []string           // solemn intonation of the gospel canticles, repeated on every verse
+	Tenor        string             // reciting note of the first half of the verse
+	Tenor2       string             // reciting note of the second half, when it differs from the first, like in the tonus peregrinus
+	Flex         cadence            // at a †
+	Mediant      cadence            // at a *
+	Terminations map[string]cadence // keyed by the differentia, named after its final note
+	Default      string             // the differentia used when none is given
+}
+
+// cadence is a melodic formula attached to the end of a part of a verse: its accents are anchored on the last stressed syllables,
+// and its preparatory notes go to the syllables right before the first accent, whatever their stress.
+type cadence struct {
+	preparatory []string
+	accents     []accent // the first one first
+}
+
+type accent struct {
+	tonic string // note of the accented syllable
+	after string // note of the unaccented syllables after it, added to the accented syllable itself when there are none
+}
+
+// parseCadence reads a cadence written as its notes separated by spaces, with a "'" before the accented ones,
+// like "g h 'ixi h": two preparatory notes, then an accent followed by the note of the unaccented syllables.
+func parseCadence(s string) cadence {
+	var c cadence
+
+	for _, note := range strings.Fields(s) {
+		if tonic, ok := strings.CutPrefix(note, "'"); ok {
+			c.accents = append(c.accents, accent{tonic: tonic})
+			continue
+		}
+
+		switch {
+		case len(c.accents) == 0:
+			c.preparatory = append(c.preparatory, note)
+		case c.accents[len(c.accents)-1].after == "":
+			c.accents[len(c.accents)-1].after = note
+		default:
+			panic(fmt.Sprintf("psalm tone cadence %q: more than one note after an accent", s))
+		}
+	}
+
+	if len(c.accents) == 0 {
+		panic(fmt.Sprintf("psalm tone cadence %q: no accent", s))
+	}
+
+	return c
+}
+
+// terminations reads the terminations of a tone, given as pairs of differentia and cadence.
+func terminations(pairs ...string) map[string]cadence {
+	t := make(map[string]cadence, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		t[pairs[i]] = parseCadence(pairs[i+1])
+	}
+
+	return t
+}
+
+// Tones are the eight Gregorian psalm tones and the tonus peregrinus, keyed by their names.
+// They follow the simple forms of the Office, with their most usual differentiae.
+var Tones = map[string]Tone{
+	"1": {
+		Name: "1", Clef: "c4", Intonation: []string{"f", "gh"}, Tenor: "h",
+		Solemn: []string{"f", "g", "h"}, Flex: parseCadence("'h g"), Mediant: parseCadence("g h 'ixi h"),
+		Terminations: terminations("D", "g f 'e d", "f", "g f 'gh f", "g", "g f 'gh g", "a", "g f 'g h"),
+		Default:      "D",
+	},
+	"2": {
+		Name: "2", Clef: "f3", Intonation: []string{"e", "f"}, Tenor: "h",
+		Solemn: []string{"e", "f", "h"}, Flex: parseCadence("'h f"), Mediant: parseCadence("'i h"),
+		Terminations: terminations("D", "g 'g f"),
+		Default:      "D",
+	},
+	"3": {
+		Name: "3", Clef: "c4", Intonation: []string{"g", "hj"}, Tenor: "j",
+		Solemn: []string{"g", "h", "j"}, Flex: parseCadence("'j h"), Mediant: parseCadence("h 'k j"),
+		Terminations: terminations("a", "k j 'i h", "b", "k j 'k i", "g", "k j 'i g"),
+		Default:      "b",
+	},
+	"4": {
+		Name: "4", Clef: "c4", Intonation: []string{"h", "gh"}, Tenor: "h",
+		Solemn: []string{"h", "g", "h"}, Flex: parseCadence("'h g"), Mediant: parseCadence("g h 'i h"),
+		Terminations: terminations("E", "h g 'g e", "A", "g 'g h"),
+		Default:      "E",
+	},
+	"5": {
+		Name: "5", Clef: "c4", Intonation: []string{"f", "h"}, Tenor: "j",
+		Solemn: []string{"f", "h", "j"}, Flex: parseCadence("'j h"), Mediant: parseCadence("'k j"),
+		Terminations: terminations("a", "k 'i h"),
+		Default:      "a",
+	},
+	"6": {
+		Name: "6", Clef: "c4", Intonation: []string{"f", "gh"}, Tenor: "h",
+		Solemn: []string{"f", "g", "h"}, Flex: parseCadence("'h f"), Mediant: parseCadence("g 'h"),
+		Terminations: terminations("F", "f 'g f"),
+		Default:      "F",
+	},
+	"7": {
+		Name: "7", Clef: "c3", Intonation: []string{"hg", "hi"}, Tenor: "i",
+		Solemn: []string{"hg", "hi", "i"}, Flex: parseCadence("'i h"), Mediant: parseCadence("'j i 'h i"),
+		Terminations: terminations("a", "'j i 'h f", "c", "'j i 'h h", "d", "'j i 'i"),
+		Default:      "a",
+	},
+	"8": {
+		Name: "8", Clef: "c4", Intonation: []string{"g", "h"}, Tenor: "j",
+		Solemn: []string{"g", "h", "j"}, Flex: parseCadence("'j h"), Mediant: parseCadence("'k j"),
+		Terminations: terminations("G", "h j 'h g", "c", "h j 'k j"),
+		Default:      "G",
+	},
+	"per": {
+		Name: "per", Clef: "c4", Intonation: []string{"h", "i"}, Tenor: "h", Tenor2: "g",
+		Solemn: []string{"h", "i", "h"}, Flex: parseCadence("'h f"), Mediant: parseCadence("'i h"),
+		Terminations: terminations("D", "f 'g d"),
+		Default:      "D",
+	},
+}
+
+// ParseTone returns the psalm tone and differentia with the given name, like "8G", "1" or "per".
+// The default differentia of the tone is used when none is given, and its case does not matter, so "8g" is the same as "8G".
+func ParseTone(s string) (Tone, string, error) {
+	s = strings.TrimSpace(s)
+
+	name := "per"
+	if !strings.HasPrefix(strings.ToLower(s), name) {
+		name = s[:min(1, len(s))]
+	}
+
+	tone, ok := Tones[name]
+	if !ok {
+		return Tone{}, "", fmt.Errorf("psalm tone %q: %w", s, gabcErrors.ErrUnknownPsalmTone)
+	}
+
+	differentia := strings.TrimSpace(s[len(name):])
+	if differentia == "" {
+		return tone, tone.Default, nil
+	}
+
+	for d := range tone.Terminations {
+		if strings.EqualFold(d, differentia) { // no tone has two differentiae that differ only by case
+			return tone, d, nil
+		}
+	}
+
+	return Tone{}, "", fmt.Errorf("psalm tone %q: differentia %q: %w", s, differentia, gabcErrors.ErrUnknownPsalmTone)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/readings"
+)
+
+// GenerateReading attaches GABC code to each syllable of the incomming text following the given reading tone:
+// "prophecy", "epistle" or "gospel". The text is split into phrases at its punctuation, which chooses their cadences.
+func (gen GabcGen) GenerateReading(ctx context.Context, tone, text string) (string, error) {
+	score, err := gen.ComposeReading(ctx, tone, text, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeReading works like GenerateReading, taking the per-text options and returning the warnings along with the GABC.
+func (gen GabcGen) ComposeReading(ctx context.Context, tone, text string, opts Options) (Score, error) {
+	t, err := readings.ParseTone(tone)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Reading: %w", err)
+	}
+
+	// The text is sanitized before being split, so typographic punctuation and decomposed accents do not decide the sentences
+	text, sanitized := phrases.Sanitize(text)
+
+	newParagraphs, linedText, score, err := gen.buildParagraphs(ctx, readings.SplitSentences(text, opts.Language), opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Reading: %w", err)
+	}
+	score.Sanitized = sanitized
+
+	reading := readings.New(t, linedText)
+
+	if err := reading.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Reading: %w", err)
+	}
+
+	if err := reading.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Reading: %w", err)
+	}
+
+	score.GABC = reading.ComposedGABC
+
+	return score, nil
+}
+
+// ComposeAnnouncement attaches GABC code to the announcement of a reading, like "Leitura do Livro do Profeta Isaías.",
+// following its tone. The gospel announcement comes with the greeting before it and the answer of the people.
+func (gen GabcGen) ComposeAnnouncement(ctx context.Context, tone, text string, opts Options) (Score, error) {
+	t, err := readings.ParseTone(tone)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Announcement: %w", err)
+	}
+
+	newParagraphs, _, score, err := gen.buildParagraphs(ctx, text, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Announcement: %w", err)
+	}
+
+	announcement := readings.NewAnnouncement(t)
+
+	if err := announcement.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Announcement: %w", err)
+	}
+
+	if err := announcement.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Announcement: %w", err)
+	}
+
+	score.GABC = announcement.ComposedGABC
+
+	return score, nil
+}
+
+// ReadingAcclamation returns the acclamation that closes a reading of the given tone, like "Palavra do Senhor.".
+func (gen GabcGen) ReadingAcclamation(ctx context.Context, tone string) (string, error) {
+	t, err := readings.ParseTone(tone)
+	if err != nil {
+		return "", fmt.Errorf("generating Acclamation: %w", err)
+	}
+
+	return readings.Acclamation(t)
+}
@@ -0,0 +1,98 @@
+// Package readings handles specific phrase types that compose the melody of the readings of Mass: prophecy, epistle and gospel.
+package readings
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+)
+
+// The fixed formulas of the readings follow the full stop cadence of their tones.
+const (
+	// The gospel announcement is preceded by the greeting of the deacon or the priest.
+	GospelGreeting = "<c><sp>V/</sp></c> O(h) Se(h)nhor(h) es(h)te(h)ja(h) con(f)vos(g)co.(g) (::) <c><sp>R/</sp></c> E(h)le(h) es(h)tá(h) no(h) me(h)io(h) de(f) nós.(fg) (::) (Z)"
+
+	// The people answer the gospel announcement and its acclamation praising the Lord.
+	GospelResponse = "<c><sp>R/</sp></c> Gló(h)ri(h)a(h) a(h) vós,(h) Se(f)nhor.(fg) (::)"
+)
+
+var acclamations = map[Tone]string{
+	Prophecy: "<c><sp>V/</sp></c> Pa(h)la(h)vra(h) do(h) Se(g)nhor.(gf) (::) <c><sp>R/</sp></c> Gra(h)ças(h) a(g) Deus.(gf) (::)",
+	Epistle:  "<c><sp>V/</sp></c> Pa(h)la(h)vra(h) do(h) Se(g)nhor.(fe) (::) <c><sp>R/</sp></c> Gra(h)ças(h) a(g) Deus.(fe) (::)",
+	Gospel:   "<c><sp>V/</sp></c> Pa(h)la(h)vra(h) da(h) Sal(h)va(f)ção.(fg) (::) " + GospelResponse,
+}
+
+// Acclamation returns the acclamation that closes a reading, like "Palavra do Senhor.", with the answer of the people.
+func Acclamation(tone Tone) (string, error) {
+	a, ok := acclamations[tone]
+	if !ok {
+		return "", fmt.Errorf("acclamation: reading tone %q: %w", tone, gabcErrors.ErrUnknownTone)
+	}
+
+	return a, nil
+}
+
+type Announcement struct {
+	Tone         Tone
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the announcement melody
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+}
+
+// NewAnnouncement creates a new announcement struct, like "Leitura do Livro do Profeta Isaías.", with the tone of its reading.
+func NewAnnouncement(tone Tone) *Announcement { // returning a pointer because this struct is going to be modified by its methods
+	return &Announcement{
+		Tone: tone,
+	}
+}
+
+// TypePhrases types the already built phrases of the announcement: each line falls with a flex, and the last one closes it with the full stop cadence.
+func (a *Announcement) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	f, ok := tones[a.Tone]
+	if !ok {
+		return fmt.Errorf("typing phrases: reading tone %q: %w", a.Tone, gabcErrors.ErrUnknownTone)
+	}
+
+	var all []*phrases.Phrase
+	for _, p := range newParagraphs {
+		all = append(all, p.Phrases...)
+	}
+
+	for i, ph := range all {
+		if i == len(all)-1 {
+			a.Phrases = append(a.Phrases, fullStop{Phrase: *ph, formulas: f})
+			continue
+		}
+
+		a.Phrases = append(a.Phrases, flex{Phrase: *ph, formulas: f})
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the announcement and returns the composed GABC string.
+// The gospel announcement comes between the greeting and the answer of the people.
+func (a *Announcement) ApplyGabcMelodies() error {
+	composedGABC := "<c><sp>V/</sp></c> "
+
+	for _, ph := range a.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+	}
+
+	// Adjust the ending of the composed GABC string
+	composedGABC = strings.TrimSuffix(composedGABC, "(:)\n") + "(::)"
+
+	if a.Tone == Gospel {
+		composedGABC = GospelGreeting + "\n" + composedGABC + " " + GospelResponse
+	}
+
+	a.ComposedGABC = composedGABC
+
+	return nil
+}
@@ -0,0 +1,226 @@
+// Package readings handles specific phrase types that compose the melody of the readings of Mass: prophecy, epistle and gospel.
+package readings
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+type Tone string
+
+const (
+	Prophecy Tone = "prophecy" // tone of the first reading, from the Old Testament
+	Epistle  Tone = "epistle"  // tone of the second reading, from the letters of the apostles
+	Gospel   Tone = "gospel"   // tone of the gospel, sung by the deacon or the priest
+)
+
+// ParseTone returns the reading tone with the given name.
+func ParseTone(s string) (Tone, error) {
+	switch t := Tone(strings.ToLower(strings.TrimSpace(s))); t {
+	case Prophecy, Epistle, Gospel:
+		return t, nil
+	default:
+		return "", fmt.Errorf("reading tone %q: %w", s, gabcErrors.ErrUnknownTone)
+	}
+}
+
+// formulas are the reciting note and the cadences of a reading tone.
+type formulas struct {
+	recite   string
+	flex     cadence.Cadence // at a comma
+	fullStop cadence.Cadence // at the end of a sentence
+	question cadence.Cadence // at a question mark
+}
+
+// The question formula is the same in every tone: the voice drops a half tone right before the last accent and rises after it.
+var question = cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.Si, Oxytone: staff.SiDo, After: staff.Do}
+
+var tones = map[Tone]formulas{
+	Prophecy: {
+		recite:   staff.Do,
+		flex:     cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoLa, After: staff.La},
+		fullStop: cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La},
+		question: question,
+	},
+	Epistle: {
+		recite:   staff.Do,
+		flex:     cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoSi, After: staff.Si},
+		fullStop: cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.LaSol, After: staff.Sol},
+		question: question,
+	},
+	Gospel: {
+		recite:   staff.Do,
+		flex:     cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoLa, After: staff.La},
+		fullStop: cadence.Cadence{Before: []string{staff.La}, Tonic: staff.Si, Oxytone: staff.LaSi, After: staff.Si},
+		question: question,
+	},
+}
+
+type Reading struct {
+	Tone         Tone
+	LinedText    string
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the reading melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+	breaks       map[int]bool     // indexes of the phrases that end a paragraph
+}
+
+type ( // Phrase types that can occur in a Reading, chosen by the punctuation at their end
+	flex struct { // flex = reciting tone, falling after the last accent, at a comma;
+		phrases.Phrase
+		formulas formulas
+	}
+	fullStop struct { // fullStop = reciting tone, final cadence, at the end of a sentence;
+		phrases.Phrase
+		formulas formulas
+	}
+	interrogation struct { // interrogation = reciting tone, question formula anchored on the last accent, at a question mark.
+		phrases.Phrase
+		formulas formulas
+	}
+)
+
+// New creates a new reading struct with the tone and the lined text.
+func New(tone Tone, linedText string) *Reading { // returning a pointer because this struct is going to be modified by its methods
+	return &Reading{
+		Tone:      tone,
+		LinedText: linedText,
+		breaks:    make(map[int]bool),
+	}
+}
+
+// SplitSentences breaks a reading into lines at its punctuation, so each line can be typed by the cadence it ends with.
+// Paragraphs are kept, and nothing between parentheses is split, nor after the abbreviations of the language, like "Sto.".
+func SplitSentences(text, language string) string {
+	if language == "" {
+		language = phrases.DefaultLanguage
+	}
+	abbreviations := phrases.LanguageExpansions[language].Abbreviations
+
+	var paragraphs []string
+
+	for _, p := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		var lines []string
+		var line []string
+		depth := 0
+
+		for _, w := range strings.Fields(p) {
+			line = append(line, w)
+			depth += strings.Count(w, "(") - strings.Count(w, ")")
+
+			if _, ok := abbreviations[strings.TrimLeft(w, `"'([«`)]; ok {
+				continue
+			}
+
+			if depth <= 0 && strings.ContainsRune(",;:.!?", phrases.LastMark(w)) {
+				lines = append(lines, strings.Join(line, " "))
+				line = nil
+				depth = 0
+			}
+		}
+
+		if len(line) > 0 {
+			lines = append(lines, strings.Join(line, " "))
+		}
+
+		if len(lines) > 0 {
+			paragraphs = append(paragraphs, strings.Join(lines, "\n"))
+		}
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// TypePhrases types the already built phrases based on the punctuation at their end.
+// The last phrase of each paragraph always closes it with the full stop cadence, unless it is a question.
+func (reading *Reading) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	f, ok := tones[reading.Tone]
+	if !ok {
+		return fmt.Errorf("typing phrases: reading tone %q: %w", reading.Tone, gabcErrors.ErrUnknownTone)
+	}
+
+	for _, p := range newParagraphs {
+		for i, ph := range p.Phrases {
+			isLast := i == len(p.Phrases)-1
+
+			switch mark := phrases.LastMark(ph.Text); {
+			case mark == '?':
+				reading.Phrases = append(reading.Phrases, interrogation{Phrase: *ph, formulas: f})
+			case mark == ',' && !isLast:
+				reading.Phrases = append(reading.Phrases, flex{Phrase: *ph, formulas: f})
+			default:
+				reading.Phrases = append(reading.Phrases, fullStop{Phrase: *ph, formulas: f})
+			}
+		}
+
+		reading.breaks[len(reading.Phrases)-1] = true
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the reading and returns the composed GABC string.
+func (reading *Reading) ApplyGabcMelodies() error {
+	var composedGABC string
+
+	for i, ph := range reading.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+
+		if reading.breaks[i] {
+			composedGABC = strings.TrimSuffix(composedGABC, "\n") + "(Z)\n\n" // gabc code for a new line of score at the end of each paragraph
+		}
+	}
+
+	// Adjust the ending of the composed GABC string
+	composedGABC = strings.TrimSuffix(composedGABC, "(:)(Z)\n\n") + "(::)"
+
+	reading.ComposedGABC = composedGABC
+
+	return nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph flex) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.flex); err != nil {
+		return "", fmt.Errorf("flex phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(,)\n" // gabc code for the "quarter bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph fullStop) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.fullStop); err != nil {
+		return "", fmt.Errorf("full stop phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph interrogation) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, nil, ph.formulas.recite, ph.formulas.question); err != nil {
+		return "", fmt.Errorf("interrogation phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(:)\n" // gabc code for the "whole bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
@@ -0,0 +1,71 @@
+package readings_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/readings"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGenerateReading(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("picks flex, full stop and question cadences by punctuation", func(t *testing.T) {
+		is := is.New(t)
+
+		inputText := "Na verdade, é digno e justo. Digno e justo é?\n\nPor Cristo, nosso Senhor,"
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateReading(ctx, "epistle", inputText)
+		is.NoErr(err)
+
+		expectedGABC := "Na(h) ver(h)da(h)de,(g) (,)\né(h) dig(h)no(h) e(g) jus(f)to.(e) (:)\nDig(h)no(h) e(h) jus(h)to(g) é?(gh) (:)(Z)\n\nPor(h) Cris(h)to,(g) (,)\nnos(h)so(h) Se(g)nhor,(fe) (::)" // the last phrase closes the reading despite its comma
+
+		is.Equal(composedGABC, expectedGABC)
+	})
+
+	t.Run("does not split inside parentheses", func(t *testing.T) {
+		is := is.New(t)
+
+		is.Equal(readings.SplitSentences("Na verdade (digno, justo) é digno: justo é.", "pt"), "Na verdade (digno, justo) é digno:\njusto é.")
+	})
+
+	t.Run("does not split after abbreviations", func(t *testing.T) {
+		is := is.New(t)
+
+		is.Equal(readings.SplitSentences("Leitura da carta de S. Paulo, escrita pelo Sto. Apóstolo. Disse o Pe. Antônio.", "pt"), "Leitura da carta de S. Paulo,\nescrita pelo Sto. Apóstolo.\nDisse o Pe. Antônio.")
+		is.Equal(readings.SplitSentences("Epistola B. Pauli. Sequentia.", "la"), "Epistola B. Pauli.\nSequentia.")
+	})
+
+	t.Run("sanitizes the text before splitting it into sentences", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposeReading(ctx, "epistle", "Na verdade, é “digno e justo.” Digno e justo é?", service.Options{})
+		is.NoErr(err)
+
+		is.Equal(score.GABC, "Na(h) ver(h)da(h)de,(g) (,)\né(h) \"dig(h)no(h) e(g) jus(f)to.\"(e) (:)\nDig(h)no(h) e(h) jus(h)to(g) é?(gh) (::)")
+		is.Equal(len(score.Sanitized), 2) // the opening and the closing quotes, which did not hide the full stop between them
+	})
+
+	t.Run("rejects unknown tones", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateReading(ctx, "psalm", "Na verdade.")
+		is.True(errors.Is(err, gabcErrors.ErrUnknownTone))
+	})
+
+	t.Run("the gospel announcement comes between the greeting and the answer of the people", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposeAnnouncement(ctx, "gospel", "Por Cristo, nosso Senhor.", service.Options{})
+		is.NoErr(err)
+
+		is.Equal(score.GABC, readings.GospelGreeting+"\n<c><sp>V/</sp></c> Por(h) Cris(h)to,(h) nos(h)so(h) Se(f)nhor.(fg) (::) "+readings.GospelResponse)
+	})
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ramon-reichert/gabcgen/internal/service/responsorial"
+)
+
+// GenerateResponsorial attaches GABC code to each syllable of the refrain and of the stanzas of a responsorial psalm,
+// singing the stanzas on the given verse tone: "simple" or "festive". Stanzas are separated by blank lines, with one line for each phrase.
+func (gen GabcGen) GenerateResponsorial(ctx context.Context, tone, refrain, stanzas string) (string, error) {
+	score, err := gen.ComposeResponsorial(ctx, tone, refrain, stanzas, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposeResponsorial works like GenerateResponsorial, taking the per-text options and returning the warnings along with the GABC.
+func (gen GabcGen) ComposeResponsorial(ctx context.Context, tone, refrain, stanzas string, opts Options) (Score, error) {
+	t, err := responsorial.ParseVerseTone(tone)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Responsorial: %w", err)
+	}
+
+	psalm := responsorial.New(t, refrain, stanzas)
+
+	newParagraphs, _, score, err := gen.buildParagraphs(ctx, psalm.LinedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Responsorial: %w", err)
+	}
+
+	if err := psalm.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Responsorial: %w", err)
+	}
+
+	if err := psalm.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Responsorial: %w", err)
+	}
+
+	score.GABC = psalm.ComposedGABC
+
+	return score, nil
+}
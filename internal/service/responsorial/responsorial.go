@@ -0,0 +1,202 @@
+// Package responsorial handles specific phrase types that compose the melody of the responsorial psalm of Mass: a refrain and its stanzas.
+package responsorial
+
+import (
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/cadence"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/staff"
+)
+
+type PhraseMelodyer interface {
+	ApplyMelody() (string, error) // applying the Open/Closed principle from SOLID so we can always have new types of Phrases
+}
+
+type VerseTone string
+
+const (
+	Simple  VerseTone = "simple"  // verses recited on a single note, falling a tone at the end of each line
+	Festive VerseTone = "festive" // verses rising to the reciting note and falling a fifth at the end of each stanza
+)
+
+// ParseVerseTone returns the verse tone with the given name.
+func ParseVerseTone(s string) (VerseTone, error) {
+	switch t := VerseTone(strings.ToLower(strings.TrimSpace(s))); t {
+	case Simple, Festive:
+		return t, nil
+	default:
+		return "", fmt.Errorf("verse tone %q: %w", s, gabcErrors.ErrUnknownVerseTone)
+	}
+}
+
+// Repeat marks where the people repeat the refrain, after each stanza.
+const Repeat = "<c><sp>R/</sp></c> <i>Refrão.</i>() (::)"
+
+// formulas are the notes of the lines of a refrain or of a stanza.
+type formulas struct {
+	intonation string          // first syllable of the first line, if any
+	recite     string          // reciting note
+	half       cadence.Cadence // at the end of every line but the last
+	final      cadence.Cadence // at the end of the last line, leading back to the refrain
+}
+
+// The refrain is sung by the people after every stanza, so its melody is the same whatever the verse tone.
+var refrain = formulas{
+	intonation: staff.La,
+	recite:     staff.Do,
+	half:       cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.DoSi, After: staff.La},
+	final:      cadence.Cadence{Before: []string{staff.Si, staff.Do}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La},
+}
+
+var tones = map[VerseTone]formulas{
+	Simple: {
+		recite: staff.Do,
+		half:   cadence.Cadence{Tonic: staff.Do, Oxytone: staff.DoSi, After: staff.Si},
+		final:  cadence.Cadence{Before: []string{staff.Si}, Tonic: staff.La, Oxytone: staff.SiLa, After: staff.La},
+	},
+	Festive: {
+		intonation: staff.La,
+		recite:     staff.Do,
+		half:       cadence.Cadence{Before: []string{staff.Do}, Tonic: staff.Si, Oxytone: staff.SiDo, After: staff.Do},
+		final:      cadence.Cadence{Before: []string{staff.La, staff.Si}, Tonic: staff.Sol, Oxytone: staff.LaSol, After: staff.Sol},
+	},
+}
+
+type Psalm struct {
+	Tone         VerseTone
+	LinedText    string           // the refrain in the first paragraph, followed by one paragraph per stanza
+	Phrases      []PhraseMelodyer // typed phrases whose behaviors compose the psalm melodies
+	ComposedGABC string           // composed GABC string, to be generated by the ApplyGabcMelodies method
+}
+
+type ( // Phrase types that can occur in a responsorial Psalm, chosen by the position of the line in the refrain or in the stanza
+	half struct { // half = intonation, reciting tone and half cadence, at the end of any line but the last;
+		phrases.Phrase
+		formulas formulas
+		intone   bool
+	}
+	refrainEnd struct { // refrainEnd = reciting tone and final cadence, closing the refrain;
+		phrases.Phrase
+		formulas formulas
+		intone   bool
+	}
+	stanzaEnd struct { // stanzaEnd = reciting tone and final cadence, leading back to the refrain.
+		phrases.Phrase
+		formulas formulas
+		intone   bool
+	}
+)
+
+// New creates a new responsorial psalm struct with the verse tone, the refrain and the stanzas, separated by blank lines, with one line for each phrase.
+func New(tone VerseTone, refrainText, stanzas string) *Psalm { // returning a pointer because this struct is going to be modified by its methods
+	var lines []string
+	for line := range strings.Lines(refrainText) {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return &Psalm{
+		Tone:      tone,
+		LinedText: strings.Join(lines, "\n") + "\n\n" + strings.TrimSpace(strings.ReplaceAll(stanzas, "\r\n", "\n")),
+	}
+}
+
+// TypePhrases types the already built phrases: the first paragraph is the refrain, and each of the others is a stanza.
+func (psalm *Psalm) TypePhrases(newParagraphs []phrases.Paragraph) error {
+	f, ok := tones[psalm.Tone]
+	if !ok {
+		return fmt.Errorf("typing phrases: verse tone %q: %w", psalm.Tone, gabcErrors.ErrUnknownVerseTone)
+	}
+
+	if len(newParagraphs) < 2 {
+		return fmt.Errorf("typing phrases: %w", gabcErrors.ErrNoStanza)
+	}
+
+	for n, p := range newParagraphs {
+		formulas := f
+		if n == 0 {
+			formulas = refrain
+		}
+
+		for i, ph := range p.Phrases {
+			intone := i == 0 && formulas.intonation != ""
+
+			switch {
+			case i < len(p.Phrases)-1:
+				psalm.Phrases = append(psalm.Phrases, half{Phrase: *ph, formulas: formulas, intone: intone})
+			case n == 0:
+				psalm.Phrases = append(psalm.Phrases, refrainEnd{Phrase: *ph, formulas: formulas, intone: intone})
+			default:
+				psalm.Phrases = append(psalm.Phrases, stanzaEnd{Phrase: *ph, formulas: formulas, intone: intone})
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyGabcMelodies applies the GABC melodies to each phrase in the psalm and returns the composed GABC string.
+// The refrain comes first, and the mark of its repetition closes every stanza.
+func (psalm *Psalm) ApplyGabcMelodies() error {
+	composedGABC := "<c><sp>R/</sp></c> " // the people sing the refrain first, after the psalmist
+
+	for _, ph := range psalm.Phrases {
+		gabcPhrase, err := ph.ApplyMelody()
+		if err != nil {
+			return fmt.Errorf("applying melody to %w", err)
+		}
+
+		composedGABC = composedGABC + gabcPhrase
+	}
+
+	// Adjust the ending of the composed GABC string
+	psalm.ComposedGABC = strings.TrimSuffix(composedGABC, "(Z)\n\n")
+
+	return nil
+}
+
+// start is the intonation of the first syllable of a line, when it is asked and the formulas have one.
+func (f formulas) start(intone bool) []string {
+	if !intone || f.intonation == "" {
+		return nil
+	}
+
+	return []string{f.intonation}
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph half) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, ph.formulas.start(ph.intone), ph.formulas.recite, ph.formulas.half); err != nil {
+		return "", fmt.Errorf("half phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(;)\n" // gabc code for the "half bar", to be added at the end of the phrase
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph refrainEnd) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, ph.formulas.start(ph.intone), ph.formulas.recite, ph.formulas.final); err != nil {
+		return "", fmt.Errorf("refrain end phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(::)(Z)\n\n" // gabc code for the "double bar" plus new line of score (Z), to be added at the end of the refrain
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
+
+// ApplyMelody analyzes the syllables of a phrase and attaches the GABC code(note) to each one of them, following the melody rules of that specific phrase type.
+func (ph stanzaEnd) ApplyMelody() (string, error) {
+	if err := cadence.Sing(ph.Syllables, ph.formulas.start(ph.intone), ph.formulas.recite, ph.formulas.final); err != nil {
+		return "", fmt.Errorf("stanza end phrase: %v: %w ", ph.Text, err)
+	}
+
+	end := "(::) " + Repeat + "(Z)\n\n" // the refrain is repeated after every stanza
+
+	return phrases.JoinSyllables(ph.Syllables, end, ph.Directives), nil
+}
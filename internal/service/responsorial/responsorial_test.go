@@ -0,0 +1,42 @@
+package responsorial_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/platform/syllabification/mocksyllabifier"
+	"github.com/ramon-reichert/gabcgen/internal/service"
+	"github.com/ramon-reichert/gabcgen/internal/service/responsorial"
+)
+
+var ctx context.Context = context.Background()
+
+func TestGenerateResponsorial(t *testing.T) {
+	syllabifier := mocksyllabifier.NewSyllabifier()
+
+	t.Run("repeats the refrain after each stanza", func(t *testing.T) {
+		is := is.New(t)
+
+		composedGABC, err := service.NewGabcGenAPI(syllabifier).GenerateResponsorial(ctx, "simple", "Cristo, nosso Senhor.", "Na verdade, é digno\ne justo.\n\nPor isso, é justo.")
+		is.NoErr(err)
+
+		expectedGABC := "<c><sp>R/</sp></c> Cris(f)to,(h) nos(h)so(h) Se(g)nhor.(gf) (::)(Z)\n\n" +
+			"Na(h) ver(h)da(h)de,(h) é(h) dig(h)no(g) (;)\ne(g) jus(f)to.(f) (::) " + responsorial.Repeat + "(Z)\n\n" +
+			"Por(h) is(h)so,(h) é(g) jus(f)to.(f) (::) " + responsorial.Repeat
+
+		is.Equal(composedGABC, expectedGABC)
+	})
+
+	t.Run("needs a stanza after the refrain", func(t *testing.T) {
+		is := is.New(t)
+
+		_, err := service.NewGabcGenAPI(syllabifier).GenerateResponsorial(ctx, "simple", "Cristo, nosso Senhor.", "")
+		is.True(errors.Is(err, gabcErrors.ErrNoStanza))
+
+		_, err = service.NewGabcGenAPI(syllabifier).GenerateResponsorial(ctx, "solemn", "Cristo, nosso Senhor.", "Na verdade.")
+		is.True(errors.Is(err, gabcErrors.ErrUnknownVerseTone))
+	})
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+// PendingWords lists the words that could not be syllabified and wait for review.
+func (gen GabcGen) PendingWords(ctx context.Context) ([]words.PendingWord, error) {
+	queue, ok := gen.Syllabifier.(words.ReviewQueue)
+	if !ok {
+		return nil, gabcErrors.ErrNoReviewQueue
+	}
+
+	pending, err := queue.PendingWords()
+	if err != nil {
+		return nil, fmt.Errorf("listing pending words: %w", err)
+	}
+
+	return pending, nil
+}
+
+// ResolvePendingWord stores the reviewed syllables of a pending word into the user database, taking it out of the queue.
+func (gen GabcGen) ResolvePendingWord(ctx context.Context, word, slashed string, tonicIndex int) error {
+	queue, ok := gen.Syllabifier.(words.ReviewQueue)
+	if !ok {
+		return gabcErrors.ErrNoReviewQueue
+	}
+
+	// Words are kept in lower case, the same way they are syllabified
+	word = strings.ToLower(strings.TrimSpace(word))
+	slashed = strings.ToLower(strings.TrimSpace(slashed))
+
+	if err := queue.ResolvePending(word, slashed, tonicIndex); err != nil {
+		return fmt.Errorf("resolving pending word %v: %w", word, err)
+	}
+
+	return nil
+}
+
+// DismissPendingWord takes a word that should not be syllabified out of the queue.
+func (gen GabcGen) DismissPendingWord(ctx context.Context, word string) error {
+	queue, ok := gen.Syllabifier.(words.ReviewQueue)
+	if !ok {
+		return gabcErrors.ErrNoReviewQueue
+	}
+
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	if err := queue.DismissPending(word); err != nil {
+		return fmt.Errorf("dismissing pending word %v: %w", word, err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases"
 	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
@@ -13,6 +14,8 @@ import (
 
 type GabcGen struct {
 	Syllabifier words.Syllabifier
+	Teams       words.TeamDictionaries       // private dictionaries of the teams, looked up before the Syllabifier; nil disables them
+	Languages   map[string]words.Syllabifier // syllabifiers of the texts in other languages than phrases.DefaultLanguage, keyed by language; the Syllabifier serves the missing ones
 	// renderer    Renderer
 }
 
@@ -23,47 +26,156 @@ func NewGabcGenAPI(syllab words.Syllabifier) GabcGen {
 	}
 }
 
+// Options are per-text settings that can come along with any text to be composed.
+type Options struct {
+	Stress   map[string]int  // tonic index chosen by the user for homographs of the text, keyed by lower case word
+	Atonic   map[string]bool // overrides of the function words, keyed by lower case word: true makes a word atonic, false keeps its stress
+	Explain  bool            // whether to explain the stresses found in each phrase along with the score
+	Team     string          // team whose dictionary is looked up before the shared databases, if any
+	Language string          // language of the text, "pt" or "la", choosing its function words and fixed formulas; phrases.DefaultLanguage if empty
+}
+
+// Score is a composed GABC text along with the warnings the user should check before singing it.
+type Score struct {
+	GABC       string
+	Warnings   []phrases.Warning
+	Explain    []string                // stresses of each phrase, as shown by phrases.Explain, when asked in the Options
+	Normalized []phrases.Normalization // numbers, abbreviations and ordinals that were sung as other words
+	Sanitized  []phrases.Change        // changes made to the incoming text before composing it
+}
+
 // GeneratePreface attaches GABC code to each syllable of the incomming lined text following the preface melody rules.
 // Each line is a phrase with its corresponding melody. Pharagraphs are separated by a double newline.
 func (gen GabcGen) GeneratePreface(ctx context.Context, dialogue, linedText string) (string, error) {
+	score, err := gen.ComposePreface(ctx, dialogue, linedText, Options{})
+	if err != nil {
+		return "", err
+	}
+
+	return score.GABC, nil
+}
+
+// ComposePreface works like GeneratePreface, taking the per-text options and returning the warnings along with the GABC.
+func (gen GabcGen) ComposePreface(ctx context.Context, dialogue, linedText string, opts Options) (Score, error) {
+	newParagraphs, linedText, score, err := gen.buildParagraphs(ctx, linedText, opts)
+	if err != nil {
+		return Score{}, fmt.Errorf("generating Preface: %w", err)
+	}
+
+	prefaceText := preface.New(linedText)
+
+	if err := prefaceText.TypePhrases(newParagraphs); err != nil {
+		return Score{}, fmt.Errorf("generating Preface: %w", err)
+	}
+
+	if err := prefaceText.ApplyGabcMelodies(); err != nil {
+		return Score{}, fmt.Errorf("generating Preface: %w", err)
+	}
+
+	// Join preface dialogue and generated GABC text
+	s := string(preface.SetDialogueTone(dialogue)) + "\n\n" + prefaceText.ComposedGABC
+
+	score.GABC = fmt.Sprintf(`%v`, s)
+
+	return score, nil
+}
+
+// buildParagraphs sanitizes the lined text and distributes it into paragraphs, building the syllables of every phrase.
+// It returns the sanitized text and a Score with everything but the GABC, which depends on the melody applied to the paragraphs.
+func (gen GabcGen) buildParagraphs(ctx context.Context, linedText string, opts Options) ([]phrases.Paragraph, string, Score, error) {
+	linedText, sanitized := phrases.Sanitize(linedText)
+
 	newParagraphs, err := phrases.DistributeText(linedText)
 	if err != nil {
-		return "", fmt.Errorf("generating Preface: %w", err)
+		return nil, "", Score{}, err
+	}
+
+	warnings, err := gen.buildSyllables(ctx, newParagraphs, opts)
+	if err != nil {
+		return nil, "", Score{}, err
 	}
 
+	var normalized []phrases.Normalization
 	for _, p := range newParagraphs {
+		for _, ph := range p.Phrases {
+			normalized = append(normalized, ph.Normalized...)
+		}
+	}
 
+	return newParagraphs, linedText, Score{Warnings: warnings, Explain: explain(newParagraphs, opts), Normalized: normalized, Sanitized: sanitized}, nil
+}
+
+// explain shows the stresses of every phrase, if the options ask for it.
+func explain(paragraphs []phrases.Paragraph, opts Options) []string {
+	if !opts.Explain {
+		return nil
+	}
+
+	var e []string
+	for _, p := range paragraphs {
 		for _, ph := range p.Phrases {
+			e = append(e, phrases.Explain(ph.Syllables))
+		}
+	}
 
-			if ph.ExtractDirectives() != nil {
-				log.Println(err)
-			}
+	return e
+}
+
+// buildSyllables extracts the directives and builds the syllables of every phrase, collecting their warnings.
+func (gen GabcGen) buildSyllables(ctx context.Context, paragraphs []phrases.Paragraph, opts Options) ([]phrases.Warning, error) {
+	var warnings []phrases.Warning
+
+	if err := gen.preparePhrases(paragraphs, opts); err != nil {
+		return nil, err
+	}
 
-			ph.Syllabifier = gen.Syllabifier
+	for _, p := range paragraphs {
+
+		for _, ph := range p.Phrases {
 
 			if err := ph.BuildPhraseSyllables(ctx); err != nil {
-				return "", fmt.Errorf("generating Preface: %w", err)
+				return nil, err
 			}
+
+			warnings = append(warnings, ph.Warnings...)
 		}
 	}
 
-	// Save the user syllables to the file at once with all new words
-	if err := gen.Syllabifier.SaveSyllables(); err != nil {
-		return "", fmt.Errorf("saving user syllables: %w", err)
-	}
+	return warnings, nil
+}
 
-	prefaceText := preface.New(linedText)
+// preparePhrases extracts the directives of every phrase and gives them the syllabifier and the settings of the options.
+func (gen GabcGen) preparePhrases(paragraphs []phrases.Paragraph, opts Options) error {
+	syllabifier, err := gen.syllabifierFor(opts.Team, opts.Language)
+	if err != nil {
+		return err
+	}
 
-	if err := prefaceText.TypePhrases(newParagraphs); err != nil {
-		return "", fmt.Errorf("generating Preface: %w", err)
+	// Words are looked up in lower case
+	stress := make(map[string]int, len(opts.Stress))
+	for w, i := range opts.Stress {
+		stress[strings.ToLower(w)] = i
 	}
 
-	if err := prefaceText.ApplyGabcMelodies(); err != nil {
-		return "", fmt.Errorf("generating Preface: %w", err)
+	atonic := make(map[string]bool, len(opts.Atonic))
+	for w, a := range opts.Atonic {
+		atonic[strings.ToLower(w)] = a
 	}
 
-	// Join preface dialogue and generated GABC text
-	s := string(preface.SetDialogueTone(dialogue)) + "\n\n" + prefaceText.ComposedGABC
+	for _, p := range paragraphs {
+
+		for _, ph := range p.Phrases {
+
+			if err := ph.ExtractDirectives(); err != nil {
+				log.Println(err)
+			}
+
+			ph.Syllabifier = syllabifier
+			ph.Stress = stress
+			ph.Atonic = atonic
+			ph.Language = opts.Language
+		}
+	}
 
-	return fmt.Sprintf(`%v`, s), nil
+	return nil
 }
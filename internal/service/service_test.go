@@ -89,3 +89,30 @@ a(g) u(fe)ma(ef) só(g) voz:(fgf) (::)`
 		is.Equal(norm.NFC.String(composedGABC), norm.NFC.String(expectedGABC))
 	})
 }
+
+func TestIntegrationHomographs(t *testing.T) {
+	is := is.New(t)
+
+	syllabifier := sitesyllabifier.NewSyllabifier("../../assets/syllabledatabases/liturgical_syllables.json", "../../assets/syllabledatabases/user_syllables.json", "../../assets/syllabledatabases/not_syllabified.txt")
+	is.NoErr(syllabifier.LoadSyllables())
+
+	inputText := "Ó virgem sabia, plena de graça,\n o Pai tudo sabia\n vos chamou desde sempre."
+
+	t.Run("uncertain homographs are flagged", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposePreface(ctx, "", inputText, service.Options{})
+		is.NoErr(err)
+		is.Equal(len(score.Warnings), 1) // "virgem sabia" is certain, "Pai tudo sabia" is not
+		is.Equal(score.Warnings[0].Word, "sabia")
+		is.Equal(score.Warnings[0].Readings, []string{"sa/BI/a", "SA/bi/a"})
+	})
+
+	t.Run("per-text stress overrides settle the choice", func(t *testing.T) {
+		is := is.New(t)
+
+		score, err := service.NewGabcGenAPI(syllabifier).ComposePreface(ctx, "", inputText, service.Options{Stress: map[string]int{"Sabia": 2}})
+		is.NoErr(err)
+		is.Equal(len(score.Warnings), 0)
+	})
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	gabcErrors "github.com/ramon-reichert/gabcgen/internal/platform/errors"
+	"github.com/ramon-reichert/gabcgen/internal/service/composition/phrases/words"
+)
+
+var teamName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// teamSyllabifier looks the words up in the dictionary of a team before the shared syllabifier.
+type teamSyllabifier struct {
+	words.Syllabifier
+	team  string
+	teams words.TeamDictionaries
+}
+
+// Syllabify syllabifies a word with the team dictionary, or with the shared syllabifier if the team does not have it.
+func (s teamSyllabifier) Syllabify(ctx context.Context, word string) (string, int, error) {
+	e, ok, err := s.teams.TeamEntry(s.team, word)
+	if err != nil {
+		return "", 0, fmt.Errorf("looking %v up in the dictionary of team %v: %w", word, s.team, err)
+	}
+
+	if ok {
+		return e.Slashed, e.TonicIndex, nil
+	}
+
+	return s.Syllabifier.Syllabify(ctx, word)
+}
+
+// Candidates gives the team entry as the only reading of a word, falling back to the readings known by the shared syllabifier.
+func (s teamSyllabifier) Candidates(ctx context.Context, word string) ([]words.Candidate, error) {
+	e, ok, err := s.teams.TeamEntry(s.team, word)
+	if err != nil {
+		return nil, fmt.Errorf("looking %v up in the dictionary of team %v: %w", word, s.team, err)
+	}
+
+	if ok {
+		return []words.Candidate{{Slashed: e.Slashed, TonicIndex: e.TonicIndex}}, nil
+	}
+
+	if homographs, ok := s.Syllabifier.(words.HomographSyllabifier); ok {
+		return homographs.Candidates(ctx, word)
+	}
+
+	slashed, tonicIndex, err := s.Syllabifier.Syllabify(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+
+	return []words.Candidate{{Slashed: slashed, TonicIndex: tonicIndex}}, nil
+}
+
+// LookupEntry looks a word up in the team dictionary, then in the shared databases if they can look words up,
+// telling SourceRules when they cannot, since such a syllabifier builds every word by its rules.
+func (s teamSyllabifier) LookupEntry(word string) (words.Entry, string, error) {
+	e, ok, err := s.teams.TeamEntry(s.team, word)
+	if err != nil || ok {
+		return e, words.SourceTeam, err
+	}
+
+	if shared, ok := s.Syllabifier.(words.Lookuper); ok {
+		return shared.LookupEntry(word)
+	}
+
+	return words.Entry{}, words.SourceRules, nil
+}
+
+// syllabifierFor returns the syllabifier used for the texts of a team in the given language, or the shared one when no team is given.
+// Texts in a language with its own syllabifier, like Latin, do not reach the shared databases.
+func (gen GabcGen) syllabifierFor(team, language string) (words.Syllabifier, error) {
+	shared := gen.Syllabifier
+	if s, ok := gen.Languages[language]; ok {
+		shared = s
+	}
+
+	if team == "" {
+		return shared, nil
+	}
+
+	if err := gen.checkTeam(team); err != nil {
+		return nil, err
+	}
+
+	return teamSyllabifier{Syllabifier: shared, team: team, teams: gen.Teams}, nil
+}
+
+// checkTeam verifies that the team dictionaries are enabled and the team name is valid.
+func (gen GabcGen) checkTeam(team string) error {
+	if gen.Teams == nil {
+		return gabcErrors.ErrNoTeams
+	}
+
+	if !teamName.MatchString(team) {
+		return fmt.Errorf("team %q: %w", team, gabcErrors.ErrInvalidTeam)
+	}
+
+	return nil
+}
+
+// ExportTeamDictionary lists the whole dictionary of a team, sorted by word.
+func (gen GabcGen) ExportTeamDictionary(ctx context.Context, team string) ([]words.Entry, error) {
+	if err := gen.checkTeam(team); err != nil {
+		return nil, err
+	}
+
+	entries, err := gen.Teams.TeamEntries(team)
+	if err != nil {
+		return nil, fmt.Errorf("exporting dictionary of team %v: %w", team, err)
+	}
+
+	return entries, nil
+}
+
+// ImportTeamDictionary adds the entries to the dictionary of a team, or replaces the whole dictionary.
+// Nothing is imported if any of the entries is invalid.
+func (gen GabcGen) ImportTeamDictionary(ctx context.Context, team string, entries []words.Entry, replace bool) error {
+	if err := gen.checkTeam(team); err != nil {
+		return err
+	}
+
+	var invalid []error
+	for i, e := range entries {
+		// Words are kept in lower case, the same way they are syllabified
+		e.Word = strings.ToLower(strings.TrimSpace(e.Word))
+		e.Slashed = strings.ToLower(strings.TrimSpace(e.Slashed))
+		entries[i] = e
+
+		if err := words.ValidateSyllables(e.Word, e.Slashed, e.TonicIndex); err != nil {
+			invalid = append(invalid, err)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("importing dictionary of team %v: %w", team, errors.Join(invalid...))
+	}
+
+	if err := gen.Teams.ImportTeamEntries(team, entries, replace); err != nil {
+		return fmt.Errorf("importing dictionary of team %v: %w", team, err)
+	}
+
+	return nil
+}
+
+// TeamDictionaryDiff compares every entry of the dictionary of a team with the shared databases.
+func (gen GabcGen) TeamDictionaryDiff(ctx context.Context, team string) ([]words.TeamDifference, error) {
+	entries, err := gen.ExportTeamDictionary(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	shared, ok := gen.Syllabifier.(words.Lookuper)
+	if !ok {
+		return nil, gabcErrors.ErrNoLookup
+	}
+
+	diff := make([]words.TeamDifference, 0, len(entries))
+	for _, e := range entries {
+		s, database, err := shared.LookupEntry(e.Word)
+		if err != nil {
+			return nil, fmt.Errorf("comparing dictionary of team %v: %w", team, err)
+		}
+
+		d := words.TeamDifference{Word: e.Word, Status: words.DiffSame, Team: e, Shared: s}
+		switch {
+		case database == "":
+			d.Status = words.DiffAdded
+		case s.Slashed != e.Slashed || s.TonicIndex != e.TonicIndex:
+			d.Status = words.DiffChanged
+		}
+
+		diff = append(diff, d)
+	}
+
+	return diff, nil
+}